@@ -97,6 +97,53 @@ else
     fail "Get class info" "$RESULT"
 fi
 
+# ============================================================================
+# CUSTOM CSS TESTS
+# ============================================================================
+
+echo ""
+echo "--- Custom CSS Tests ---"
+
+# Test: Allowed properties and values are stored
+RESULT=$(feed_api_curl POST "/css/set" --data-urlencode "css=.post { color: #336699; padding: 4px }")
+if echo "$RESULT" | grep -q 'color: #336699'; then
+    pass "Set custom CSS"
+else
+    fail "Set custom CSS" "$RESULT"
+fi
+
+# Test: Properties outside the allowlist are rejected
+RESULT=$(feed_api_curl POST "/css/set" --data-urlencode "css=.post { position: fixed }")
+if echo "$RESULT" | grep -q '"error"'; then
+    pass "Reject CSS property outside the allowlist"
+else
+    fail "Reject CSS property outside the allowlist" "$RESULT"
+fi
+
+# Test: Functions other than colours are rejected
+RESULT=$(feed_api_curl POST "/css/set" --data-urlencode "css=.post { background: url(https://example.com/x.png) }")
+if echo "$RESULT" | grep -q '"error"'; then
+    pass "Reject CSS url()"
+else
+    fail "Reject CSS url()" "$RESULT"
+fi
+
+# Test: At-rules are rejected
+RESULT=$(feed_api_curl POST "/css/set" --data-urlencode "css=@import 'https://example.com/x.css'; .post { color: red }")
+if echo "$RESULT" | grep -q '"error"'; then
+    pass "Reject CSS at-rule"
+else
+    fail "Reject CSS at-rule" "$RESULT"
+fi
+
+# Test: The stored CSS is served scoped to the feed's content
+RESULT=$(feed_api_curl GET "/css")
+if echo "$RESULT" | grep -q '.feed-custom .post' && ! echo "$RESULT" | grep -q 'position'; then
+    pass "Serve scoped custom CSS"
+else
+    fail "Serve scoped custom CSS" "$RESULT"
+fi
+
 # ============================================================================
 # POST LIFECYCLE TESTS
 # ============================================================================
@@ -141,6 +188,73 @@ else
     fail "Edit post" "$RESULT"
 fi
 
+# ============================================================================
+# JOURNAL REPLAY TESTS
+# ============================================================================
+
+echo ""
+echo "--- Journal Replay Tests ---"
+
+# A replayed snapshot must update the post in place, keeping what it doesn't carry
+RESULT=$(feed_api_curl POST "/post/create" -F "body=Post to replay" -F "visibility=subscribers")
+REPLAY_POST_ID=$(echo "$RESULT" | python3 -c "import sys, json; print(json.load(sys.stdin)['data']['id'])" 2>/dev/null)
+if [ -n "$REPLAY_POST_ID" ]; then
+    pass "Create post to replay (id: $REPLAY_POST_ID)"
+else
+    fail "Create post to replay" "$RESULT"
+fi
+
+# Build a one-entry journal for a post snapshot: id, body, updated
+journal_entry() {
+    python3 -c "import sys, json; print(json.dumps({'entries': [{'feed': sys.argv[1], 'event': 'post', 'data': {'id': sys.argv[2], 'body': sys.argv[3], 'created': 1, 'updated': int(sys.argv[4])}}]}))" "$FEED_ENTITY" "$1" "$2" "$3"
+}
+
+# Test: Entry with an invalid id is skipped
+RESULT=$("$CURL_HELPER" -a admin -X POST --data-urlencode "entries=$(journal_entry "../bad id" "Bad" 4000000000)" "/feeds/-/journal/replay")
+if echo "$RESULT" | grep -q '"applied":0' && echo "$RESULT" | grep -q '"skipped":1'; then
+    pass "Journal replay skips an invalid id"
+else
+    fail "Journal replay skips an invalid id" "$RESULT"
+fi
+
+# Test: Snapshot older than the stored post is skipped
+RESULT=$("$CURL_HELPER" -a admin -X POST --data-urlencode "entries=$(journal_entry "$REPLAY_POST_ID" "Stale replay" 1)" "/feeds/-/journal/replay")
+if echo "$RESULT" | grep -q '"applied":0'; then
+    pass "Journal replay skips an older snapshot"
+else
+    fail "Journal replay skips an older snapshot" "$RESULT"
+fi
+
+# Test: Newer snapshot is applied, and the post keeps its visibility
+RESULT=$("$CURL_HELPER" -a admin -X POST --data-urlencode "entries=$(journal_entry "$REPLAY_POST_ID" "Replayed post content" 4000000000)" "/feeds/-/journal/replay")
+if echo "$RESULT" | grep -q '"applied":1'; then
+    pass "Journal replay applies a newer snapshot"
+else
+    fail "Journal replay applies a newer snapshot" "$RESULT"
+fi
+
+RESULT=$(feed_api_curl GET "/$REPLAY_POST_ID")
+if echo "$RESULT" | grep -q "Replayed post content" && echo "$RESULT" | grep -q '"visibility":"subscribers"'; then
+    pass "Replayed post keeps its visibility"
+else
+    fail "Replayed post keeps its visibility" "$RESULT"
+fi
+
+# Test: Replaying the same snapshot again changes nothing
+RESULT=$("$CURL_HELPER" -a admin -X POST --data-urlencode "entries=$(journal_entry "$REPLAY_POST_ID" "Replayed post content" 4000000000)" "/feeds/-/journal/replay")
+if echo "$RESULT" | grep -q '"applied":0'; then
+    pass "Journal replay is idempotent"
+else
+    fail "Journal replay is idempotent" "$RESULT"
+fi
+
+RESULT=$(feed_api_curl POST "/$REPLAY_POST_ID/delete")
+if echo "$RESULT" | grep -q '"ok":true'; then
+    pass "Delete replayed post"
+else
+    fail "Delete replayed post" "$RESULT"
+fi
+
 # ============================================================================
 # REACTION TESTS
 # ============================================================================
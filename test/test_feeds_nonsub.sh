@@ -160,6 +160,82 @@ else
     fi
 fi
 
+# ============================================================================
+# TEST: Non-subscriber cannot see a subscribers-only post
+# ============================================================================
+
+echo ""
+echo "--- Post Visibility Test ---"
+
+RESULT=$("$CURL" -i 1 -a admin -X POST \
+    -F "body=Subscribers only post" -F "visibility=subscribers" \
+    "/feeds/$FEED_ID/-/post/create")
+RESTRICTED_POST_ID=$(echo "$RESULT" | python3 -c "import sys, json; print(json.load(sys.stdin)['data']['id'])" 2>/dev/null)
+
+if [ -n "$RESTRICTED_POST_ID" ]; then
+    pass "Create subscribers-only post (id: $RESTRICTED_POST_ID)"
+else
+    fail "Create subscribers-only post" "$RESULT"
+fi
+
+sleep 1
+
+RESULT=$("$CURL" -i 2 -a admin -X GET "/feeds/$FEED_ID/-/posts")
+if echo "$RESULT" | grep -q "Subscribers only post"; then
+    fail "Subscribers-only post hidden from non-subscriber's feed view" "$RESULT"
+else
+    pass "Subscribers-only post hidden from non-subscriber's feed view"
+fi
+
+RESULT=$("$CURL" -i 2 -a admin -X GET "/feeds/$FEED_ID/-/$RESTRICTED_POST_ID")
+if echo "$RESULT" | grep -q "Subscribers only post"; then
+    fail "Subscribers-only post hidden from non-subscriber's post view" "$RESULT"
+else
+    pass "Subscribers-only post hidden from non-subscriber's post view"
+fi
+
+# The public post is still there
+RESULT=$("$CURL" -i 2 -a admin -X GET "/feeds/$FEED_ID/-/posts")
+if echo "$RESULT" | grep -q '"body":"This is a post'; then
+    pass "Public post still visible to non-subscriber"
+else
+    fail "Public post still visible to non-subscriber" "$RESULT"
+fi
+
+# ============================================================================
+# TEST: Hidden comments are shown only to the feed's owner
+# ============================================================================
+
+echo ""
+echo "--- Hidden Comment Test ---"
+
+RESULT=$("$CURL" -i 1 -a admin -X POST -H "Content-Type: application/json" \
+    -d '{"body":"Comment the owner hides"}' "/feeds/$FEED_ID/-/$OWNER_POST_ID/comment/create")
+HIDDEN_COMMENT_ID=$(echo "$RESULT" | python3 -c "import sys, json; print(json.load(sys.stdin)['data']['id'])" 2>/dev/null)
+
+RESULT=$("$CURL" -i 1 -a admin -X POST "/feeds/$FEED_ID/-/$OWNER_POST_ID/$HIDDEN_COMMENT_ID/hide")
+if echo "$RESULT" | grep -q '"hidden":true'; then
+    pass "Owner hides comment (id: $HIDDEN_COMMENT_ID)"
+else
+    fail "Owner hides comment" "$RESULT"
+fi
+
+sleep 1
+
+RESULT=$("$CURL" -i 2 -a admin -X GET "/feeds/$FEED_ID/-/$OWNER_POST_ID")
+if echo "$RESULT" | grep -q "Comment the owner hides"; then
+    fail "Hidden comment left out for non-subscriber" "$RESULT"
+else
+    pass "Hidden comment left out for non-subscriber"
+fi
+
+RESULT=$("$CURL" -i 1 -a admin -X GET "/feeds/$FEED_ID/-/$OWNER_POST_ID")
+if echo "$RESULT" | grep -q "Comment the owner hides"; then
+    pass "Hidden comment still shown to owner"
+else
+    fail "Hidden comment still shown to owner" "$RESULT"
+fi
+
 # ============================================================================
 # TEST: Subscribe and then interact
 # ============================================================================
@@ -211,6 +287,20 @@ else
     fail "Reaction synced to owner" "$RESULT"
 fi
 
+# After subscribing, the subscribers-only post arrives, and the copy keeps its
+# visibility so it isn't served from there to anyone else
+RESULT=$("$CURL" -i 2 -a admin -X GET "/feeds/$FEED_ID/-/$RESTRICTED_POST_ID")
+if echo "$RESULT" | grep -q "Subscribers only post"; then
+    pass "Subscriber receives subscribers-only post"
+    if echo "$RESULT" | grep -q '"visibility":"subscribers"'; then
+        pass "Subscriber's copy keeps the post's visibility"
+    else
+        fail "Subscriber's copy keeps the post's visibility" "$RESULT"
+    fi
+else
+    fail "Subscriber receives subscribers-only post" "$RESULT"
+fi
+
 # ============================================================================
 # CLEANUP
 # ============================================================================
@@ -0,0 +1,186 @@
+#!/bin/bash
+# Copyright © 2026 Mochisoft OÜ
+# SPDX-License-Identifier: AGPL-3.0-only
+# This file is part of Mochi, licensed under the GNU AGPL v3 with the
+# Mochi Application Interface Exception - see license.txt and license-exception.md.
+
+# Feeds P2P relay test suite
+# Tests posts forwarded by a relay to its shard across three instances
+#
+# Note: Each shard member checks a relayed post against the SHA-256 digest the
+# owner sends it directly, and drops the post if they differ. Several posts are
+# sent so that digests of all shapes are exercised.
+
+set -e
+
+CURL="/home/alistair/mochi/test/claude/curl.sh"
+RELAYED_POSTS=8
+
+PASSED=0
+FAILED=0
+
+pass() {
+    echo "[PASS] $1"
+    ((PASSED++)) || true
+}
+
+fail() {
+    echo "[FAIL] $1: $2"
+    ((FAILED++)) || true
+}
+
+# Member IDs of the feed, one per line
+member_ids() {
+    "$CURL" -i 1 -a admin -X GET "/feeds/$FEED_ID/-/members" | python3 -c "import sys, json; print('\n'.join(m['id'] for m in json.load(sys.stdin)['data']['members']))" 2>/dev/null
+}
+
+echo "=============================================="
+echo "Feeds Relay P2P Test Suite"
+echo "=============================================="
+
+# ============================================================================
+# SETUP: Create feed on instance 1, subscribe from instances 2 and 3
+# ============================================================================
+
+echo ""
+echo "--- Setup: Create Feed and Subscribe ---"
+
+RESULT=$("$CURL" -i 1 -a admin -X POST -H "Content-Type: application/json" \
+    -d '{"name":"Relay Test Feed","privacy":"public"}' "/feeds/create")
+FEED_ID=$(echo "$RESULT" | python3 -c "import sys, json; print(json.load(sys.stdin)['data']['id'])" 2>/dev/null)
+
+if [ -n "$FEED_ID" ]; then
+    pass "Create feed on instance 1 (id: $FEED_ID)"
+else
+    fail "Create feed" "$RESULT"
+    exit 1
+fi
+
+BEFORE=$(member_ids)
+
+RESULT=$("$CURL" -i 2 -a admin -X POST "/feeds/$FEED_ID/-/subscribe")
+if echo "$RESULT" | grep -q '"data"\|"fingerprint"'; then
+    pass "Subscribe from instance 2"
+else
+    fail "Subscribe from instance 2" "$RESULT"
+fi
+
+sleep 2
+
+RELAY_ID=$(member_ids | grep -vxF "$BEFORE" | head -1)
+if [ -n "$RELAY_ID" ]; then
+    pass "Instance 2 is a member (id: $RELAY_ID)"
+else
+    fail "Instance 2 is a member" "$(member_ids)"
+    exit 1
+fi
+
+RESULT=$("$CURL" -i 3 -a admin -X POST "/feeds/$FEED_ID/-/subscribe")
+if echo "$RESULT" | grep -q '"data"\|"fingerprint"'; then
+    pass "Subscribe from instance 3"
+else
+    fail "Subscribe from instance 3" "$RESULT"
+fi
+
+sleep 2
+
+# ============================================================================
+# TEST: Name instance 2 as relay
+# ============================================================================
+
+echo ""
+echo "--- Relay Assignment Test ---"
+
+RESULT=$("$CURL" -i 1 -a admin -X POST --data-urlencode "relays=$RELAY_ID" "/feeds/$FEED_ID/-/relays/set")
+if echo "$RESULT" | grep -q "\"id\":\"$RELAY_ID\""; then
+    pass "Set relay"
+else
+    fail "Set relay" "$RESULT"
+fi
+
+if echo "$RESULT" | grep -q '"subscribers":0'; then
+    fail "Relay has a shard" "$RESULT"
+else
+    pass "Relay has a shard"
+fi
+
+sleep 2
+
+# ============================================================================
+# TEST: Relayed posts reach the shard and match the owner's digest
+# ============================================================================
+
+echo ""
+echo "--- Relayed Post Test ---"
+
+POST_IDS=""
+for i in $(seq 1 $RELAYED_POSTS); do
+    RESULT=$("$CURL" -i 1 -a admin -X POST \
+        -F "body=Relayed post $i of $RELAYED_POSTS" \
+        "/feeds/$FEED_ID/-/post/create")
+    POST_ID=$(echo "$RESULT" | python3 -c "import sys, json; print(json.load(sys.stdin)['data']['id'])" 2>/dev/null)
+    if [ -n "$POST_ID" ]; then
+        POST_IDS="$POST_IDS $POST_ID"
+    else
+        fail "Create relayed post $i" "$RESULT"
+    fi
+done
+
+sleep 3
+
+RESULT=$("$CURL" -i 3 -a admin -X GET "/feeds/$FEED_ID/-/posts")
+MISSING=0
+for POST_ID in $POST_IDS; do
+    if ! echo "$RESULT" | grep -q "\"id\":\"$POST_ID\""; then
+        ((MISSING++)) || true
+    fi
+done
+if [ $MISSING -eq 0 ]; then
+    pass "All $RELAYED_POSTS relayed posts reached instance 3"
+else
+    fail "All $RELAYED_POSTS relayed posts reached instance 3" "$MISSING missing"
+fi
+
+# The relay keeps its own copy too
+RESULT=$("$CURL" -i 2 -a admin -X GET "/feeds/$FEED_ID/-/posts")
+if echo "$RESULT" | grep -q "Relayed post 1 of $RELAYED_POSTS"; then
+    pass "Relay received the posts"
+else
+    fail "Relay received the posts" "$RESULT"
+fi
+
+# ============================================================================
+# CLEANUP
+# ============================================================================
+
+echo ""
+echo "--- Cleanup ---"
+
+RESULT=$("$CURL" -i 1 -a admin -X POST --data-urlencode "relays=" "/feeds/$FEED_ID/-/relays/set")
+if echo "$RESULT" | grep -q '"relays":\[\]'; then
+    pass "Clear relays"
+else
+    fail "Clear relays" "$RESULT"
+fi
+
+for INSTANCE in 2 3; do
+    RESULT=$("$CURL" -i $INSTANCE -a admin -X POST "/feeds/$FEED_ID/-/unsubscribe")
+    if echo "$RESULT" | grep -q '"data"'; then
+        pass "Unsubscribe from instance $INSTANCE"
+    else
+        fail "Unsubscribe from instance $INSTANCE" "$RESULT"
+    fi
+done
+
+# ============================================================================
+# SUMMARY
+# ============================================================================
+
+echo ""
+echo "=============================================="
+echo "Results: $PASSED passed, $FAILED failed"
+echo "=============================================="
+
+if [ $FAILED -gt 0 ]; then
+    exit 1
+fi
@@ -73,6 +73,23 @@ const toReactionCounts = (
   return counts
 }
 
+// Stored per-post counts already include the viewer's own reaction; posts
+// from remote feeds may still carry reaction rows instead
+const toPostReactionCounts = (
+  post: Post
+): ReturnType<typeof createReactionCounts> => {
+  if (!post.reaction_counts) {
+    return toReactionCounts(post.reactions, post.my_reaction)
+  }
+  const counts = createReactionCounts()
+  Object.entries(post.reaction_counts).forEach(([reaction, count]) => {
+    if (isReactionId(reaction)) {
+      counts[reaction] = count
+    }
+  })
+  return counts
+}
+
 const mapComment = (comment: ApiComment): FeedComment => {
   return {
     id: comment.id,
@@ -87,6 +104,8 @@ const mapComment = (comment: ApiComment): FeedComment => {
       : null,
     attachments: comment.attachments,
     replies: comment.children?.map(mapComment) ?? [],
+    warning: comment.warning || undefined,
+    highlighted: !!comment.highlighted,
   }
 }
 
@@ -161,7 +180,7 @@ export const mapPosts = (posts?: Post[]): FeedPost[] => {
       post.attachments && post.attachments.length > 0
         ? post.attachments
         : undefined,
-    reactions: toReactionCounts(post.reactions, post.my_reaction),
+    reactions: toPostReactionCounts(post),
     userReaction: isReactionId(post.my_reaction) ? post.my_reaction : null,
     comments: (post.comments ?? []).map(mapComment),
     feedFingerprint: post.feed_fingerprint,
@@ -170,5 +189,9 @@ export const mapPosts = (posts?: Post[]): FeedPost[] => {
     read: post.read ?? 0,
     source: post.source,
     score: post.score,
+    warning: post.warning || undefined,
+    sensitive: post.sensitive ? true : undefined,
+    title: post.title || undefined,
+    summary: post.summary || undefined,
   }))
 }
@@ -40,11 +40,21 @@ const endpoints = {
     // Entity-level endpoints (use /-/ separator)
     entityInfo: (feedId: string) => `${feedId}/-/info`,
     share: (feedId: string) => `${feedId}/-/share`,
+    formattingSet: (feedId: string) => `${feedId}/-/formatting/set`,
+    ratingSet: (feedId: string) => `${feedId}/-/rating/set`,
+    invites: {
+      list: (feedId: string) => `${feedId}/-/invites`,
+      create: (feedId: string) => `${feedId}/-/invites/create`,
+      revoke: (feedId: string) => `${feedId}/-/invites/revoke`,
+    },
     posts: (feedId: string) => `${feedId}/-/posts`,
     delete: (feedId: string) => `${feedId}/-/delete`,
     rename: (feedId: string) => `${feedId}/-/rename`,
     bannerGet: (feedId: string) => `${feedId}/-/banner/get`,
     bannerSet: (feedId: string) => `${feedId}/-/banner/set`,
+    css: (feedId: string) => `${feedId}/-/css`,
+    cssSet: (feedId: string) => `${feedId}/-/css/set`,
+    noticeDismiss: (feedId: string) => `${feedId}/-/notice/dismiss`,
 
     // Post actions
     post: {
@@ -55,11 +65,15 @@ const endpoints = {
       edit: (feedId: string, postId: string) => `${feedId}/-/${postId}/edit`,
       delete: (feedId: string, postId: string) => `${feedId}/-/${postId}/delete`,
       react: (feedId: string, postId: string) => `${feedId}/-/${postId}/react`,
+      translate: (feedId: string, postId: string) => `${feedId}/-/${postId}/translate`,
+      sensitive: (feedId: string, postId: string) => `${feedId}/-/${postId}/sensitive`,
+      related: (feedId: string, postId: string) => `${feedId}/-/${postId}/related`,
     },
 
     // Read tracking
     postsRead: (feedId: string) => `${feedId}/-/posts/read`,
-    readAll: (feedId: string) => `${feedId}/-/read-all`,
+    readAll: (feedId: string) => `${feedId}/-/read`,
+    readEverything: '-/read',
 
     // Comment actions
     comment: {
@@ -72,6 +86,9 @@ const endpoints = {
       react: (feedId: string, postId: string) => `${feedId}/-/${postId}/comment/react`,
       asset: (feedId: string, postId: string, commentId: string, asset: string) =>
         `${feedId}/-/${postId}/${commentId}/asset/${asset}`,
+      highlight: (feedId: string, postId: string, commentId: string) =>
+        `${feedId}/-/${postId}/${commentId}/highlight`,
+      highlights: (feedId: string, postId: string) => `${feedId}/-/${postId}/highlights`,
     },
 
     // Member search (for @mention autocomplete)
@@ -81,6 +98,9 @@ const endpoints = {
     access: (feedId: string) => `${feedId}/-/access`,
     accessSet: (feedId: string) => `${feedId}/-/access/set`,
     accessRevoke: (feedId: string) => `${feedId}/-/access/revoke`,
+    transfers: (feedId: string) => `${feedId}/-/transfers`,
+    transferApprove: (feedId: string) => `${feedId}/-/transfers/approve`,
+    transferDecline: (feedId: string) => `${feedId}/-/transfers/decline`,
 
     // AI settings
     aiSettings: (feedId: string) => `${feedId}/-/ai/settings`,
@@ -110,6 +130,15 @@ const endpoints = {
     // Sort persistence
     sortSet: '-/sort/set',
     feedSortSet: (feedId: string) => `${feedId}/-/sort/set`,
+
+    // Masked words
+    maskSet: '-/mask/set',
+
+    // Sensitive media
+    sensitiveSet: '-/sensitive/set',
+
+    // Mature feeds in search
+    matureSet: '-/mature/set',
   },
 } as const
 
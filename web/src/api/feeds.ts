@@ -8,7 +8,7 @@ import endpoints from '@/api/endpoints'
 import { requestHelpers, createAppClient, getAppPath } from '@mochi/web'
 
 const client = createAppClient({ appName: 'feeds' })
-import type { CreateCommentRequest, CreateCommentResponse, CreateFeedRequest, CreateFeedResponse, CreatePostRequest, CreatePostResponse, DeleteCommentResponse, DeleteFeedResponse, DeletePostResponse, EditCommentResponse, EditPostRequest, EditPostResponse, FindFeedsResponse, GetNewCommentResponse, GetNewPostParams, GetNewPostResponse, ProbeFeedParams, ProbeFeedResponse, ReactToCommentResponse, ReactToPostResponse, SearchFeedsParams, SearchFeedsResponse, SubscribeFeedResponse, UnsubscribeFeedResponse, ViewFeedParams, ViewFeedResponse, Source } from '@/types'
+import type { CreateCommentRequest, CreateCommentResponse, CreateFeedRequest, CreateFeedResponse, CreatePostRequest, CreatePostResponse, DeleteCommentResponse, DeleteFeedResponse, DeletePostResponse, EditCommentResponse, FeedFormatting, FeedLayout, FeedRating, EditPostRequest, EditPostResponse, FindFeedsResponse, GetNewCommentResponse, GetNewPostParams, GetNewPostResponse, ProbeFeedParams, ProbeFeedResponse, ReactToCommentResponse, ReactToPostResponse, SearchFeedsParams, SearchFeedsResponse, SubscribeFeedResponse, UnsubscribeFeedResponse, ViewFeedParams, ViewFeedResponse, Source } from '@/types'
 
 type DataEnvelope<T> = { data: T }
 type MaybeWrapped<T> = T | DataEnvelope<T>
@@ -165,6 +165,9 @@ const createFeed = async (
   if (payload.memories === false) {
     body.memories = 'false'
   }
+  if (payload.rating) {
+    body.rating = payload.rating
+  }
 
   const response = await client.post<
     CreateFeedResponse | CreateFeedResponse['data'],
@@ -239,15 +242,73 @@ const shareFeed = async (feedId: string): Promise<{ data: ShareData }> => {
   return toDataResponse<ShareData>(response, 'share feed')
 }
 
+// Invite links to a private feed: single-use, limited or expiring tokens.
+// uses 0 means any number, expires 0 never.
+export interface FeedInvite {
+  token: string
+  link: string
+  uses: number
+  used?: number
+  expires: number
+  created?: number
+}
+
+const listInvites = async (feedId: string): Promise<FeedInvite[]> => {
+  const response = await client.get<{ data: { invites: FeedInvite[] } }>(
+    endpoints.feeds.invites.list(feedId)
+  )
+  return toDataResponse<{ invites: FeedInvite[] }>(response, 'list invites').data.invites
+}
+
+const createInvite = async (
+  feedId: string,
+  uses: number,
+  days: number
+): Promise<FeedInvite> => {
+  const response = await client.post<{ data: FeedInvite }>(
+    endpoints.feeds.invites.create(feedId),
+    { uses: String(uses), days: String(days) }
+  )
+  return toDataResponse<FeedInvite>(response, 'create invite').data
+}
+
+const revokeInvite = async (feedId: string, token: string): Promise<void> => {
+  await client.post(endpoints.feeds.invites.revoke(feedId), { token })
+}
+
+const setFormatting = async (
+  feedId: string,
+  formatting: Partial<FeedFormatting>
+): Promise<FeedFormatting> => {
+  const body: Record<string, string> = {}
+  for (const [key, value] of Object.entries(formatting)) {
+    body[key] = value ? '1' : '0'
+  }
+  const response = await client.post<{ data: { formatting: FeedFormatting } }>(
+    endpoints.feeds.formattingSet(feedId),
+    body
+  )
+  return toDataResponse<{ formatting: FeedFormatting }>(response, 'set formatting').data.formatting
+}
+
+const setRating = async (feedId: string, rating: FeedRating): Promise<FeedRating> => {
+  const response = await client.post<{ data: { rating: FeedRating } }>(
+    endpoints.feeds.ratingSet(feedId),
+    { rating }
+  )
+  return toDataResponse<{ rating: FeedRating }>(response, 'set rating').data.rating
+}
+
 const subscribeToFeed = async (
   feedId: string,
   server?: string,
-  peer?: string
+  peer?: string,
+  invite?: string
 ): Promise<SubscribeFeedResponse> => {
   const response = await client.post<
     SubscribeFeedResponse | SubscribeFeedResponse['data'],
-    { feed: string; server?: string; peer?: string }
-  >(endpoints.feeds.subscribe, { feed: feedId, server, peer })
+    { feed: string; server?: string; peer?: string; invite?: string }
+  >(endpoints.feeds.subscribe, { feed: feedId, server, peer, invite })
 
   return toDataResponse<SubscribeFeedResponse['data']>(
     response,
@@ -326,6 +387,26 @@ const createPost = async (
     }
   }
 
+  if (payload.visibility) {
+    formData.append('visibility', payload.visibility)
+    for (const subscriber of payload.audience ?? []) {
+      formData.append('audience', subscriber)
+    }
+  }
+
+  if (payload.warning) {
+    formData.append('warning', payload.warning)
+  }
+  if (payload.sensitive) {
+    formData.append('sensitive', '1')
+  }
+  if (payload.title) {
+    formData.append('title', payload.title)
+  }
+  if (payload.summary) {
+    formData.append('summary', payload.summary)
+  }
+
   const response = await client.post<
     CreatePostResponse | CreatePostResponse['data'],
     FormData
@@ -356,6 +437,39 @@ const reactToPost = async (
   return toDataResponse<ReactToPostResponse['data']>(response, 'react to post')
 }
 
+// A post, or one of its comments, translated into a language such as "fr"
+interface TranslateResponse {
+  id: string
+  language: string
+  body: string
+  cached: boolean
+}
+
+const translatePost = async (
+  feedId: string,
+  postId: string,
+  language: string,
+  commentId?: string
+): Promise<TranslateResponse> => {
+  const response = await client.post<{ data: TranslateResponse }>(
+    endpoints.feeds.post.translate(feedId, postId),
+    { feed: feedId, post: postId, language, comment: commentId ?? '' }
+  )
+  return toDataResponse<TranslateResponse>(response, 'translate post').data
+}
+
+const setPostSensitive = async (
+  feedId: string,
+  postId: string,
+  sensitive: boolean
+): Promise<boolean> => {
+  const response = await client.post<{ data: { post: string; sensitive: boolean } }>(
+    endpoints.feeds.post.sensitive(feedId, postId),
+    { feed: feedId, post: postId, sensitive: sensitive ? '1' : '0' }
+  )
+  return toDataResponse<{ post: string; sensitive: boolean }>(response, 'set post sensitive').data.sensitive
+}
+
 const editPost = async (
   payload: EditPostRequest
 ): Promise<EditPostResponse> => {
@@ -363,6 +477,15 @@ const editPost = async (
   formData.append('feed', payload.feed)
   formData.append('post', payload.post)
   formData.append('body', payload.body)
+  if (payload.warning) {
+    formData.append('warning', payload.warning)
+  }
+  if (payload.title) {
+    formData.append('title', payload.title)
+  }
+  if (payload.summary) {
+    formData.append('summary', payload.summary)
+  }
 
   // Add optional data as JSON (checkin, travelling)
   if (payload.data && Object.keys(payload.data).length > 0) {
@@ -442,6 +565,9 @@ const createComment = async (
   if (payload.id) {
     formData.append('id', payload.id)
   }
+  if (payload.warning) {
+    formData.append('warning', payload.warning)
+  }
   if (payload.files) {
     for (const file of payload.files) {
       formData.append('files', file)
@@ -579,6 +705,81 @@ const revokeAccess = async (
   return toDataResponse<AccessModifyResponse['data']>(response, 'revoke access')
 }
 
+// A subscription handed to another identity, whose grants wait for approval
+export interface Transfer {
+  previous: string
+  successor: string
+  operations: string[]
+  created: number
+}
+
+const getTransfers = async (feedId: string): Promise<Transfer[]> => {
+  const response = await client.get<{ data: { transfers: Transfer[] } }>(
+    endpoints.feeds.transfers(feedId)
+  )
+  return toDataResponse<{ transfers: Transfer[] }>(response, 'list transfers').data.transfers ?? []
+}
+
+// Approve or decline copying the previous identity's grants to its successor
+const answerTransfer = async (
+  feedId: string,
+  previous: string,
+  approve: boolean
+): Promise<boolean> => {
+  const response = await client.post<{ data: { ok: boolean } }>(
+    approve ? endpoints.feeds.transferApprove(feedId) : endpoints.feeds.transferDecline(feedId),
+    { previous }
+  )
+  return toDataResponse<{ ok: boolean }>(response, 'answer transfer').data.ok
+}
+
+// Another post of the same feed, suggested under a post
+export interface RelatedPost {
+  id: string
+  body: string
+  created: number
+  type: string
+}
+
+// Posts of the same feed sharing tags or wording with a post
+const getRelatedPosts = async (feedId: string, postId: string): Promise<RelatedPost[]> => {
+  const response = await client.get<{ data: { posts: RelatedPost[] } }>(
+    endpoints.feeds.post.related(feedId, postId)
+  )
+  return toDataResponse<{ posts: RelatedPost[] }>(response, 'related posts').data.posts ?? []
+}
+
+// A comment the feed's owner highlighted
+export interface HighlightedComment {
+  id: string
+  parent: string
+  subscriber: string
+  name: string
+  body: string
+  created: number
+}
+
+const getHighlights = async (feedId: string, postId: string): Promise<HighlightedComment[]> => {
+  const response = await client.get<{ data: { comments: HighlightedComment[] } }>(
+    endpoints.feeds.comment.highlights(feedId, postId)
+  )
+  return toDataResponse<{ comments: HighlightedComment[] }>(response, 'highlights').data.comments ?? []
+}
+
+// Highlight a comment, or clear its highlight (owner only)
+const highlightComment = async (
+  feedId: string,
+  postId: string,
+  commentId: string,
+  highlighted: boolean
+): Promise<boolean> => {
+  const response = await client.post<{ data: { highlighted: boolean } }>(
+    endpoints.feeds.comment.highlight(feedId, postId, commentId),
+    { highlighted: highlighted ? '1' : '0' }
+  )
+  return toDataResponse<{ highlighted: boolean }>(response, 'highlight comment').data.highlighted
+}
+
 // User search result from People app
 export interface UserSearchResult {
   id: string
@@ -760,14 +961,28 @@ const postsRead = async (
   })
 }
 
-const readAll = async (
-  feedId: string
-): Promise<{ read: number }> => {
-  const response = await client.post<{ data: { ok: boolean; read: number } }>(
+// Unread counts after marking read, by feed ID, and their total
+interface ReadResponse {
+  ok: boolean
+  read: number
+  unread: Record<string, number>
+  total: number
+}
+
+const readAll = async (feedId: string): Promise<ReadResponse> => {
+  const response = await client.post<{ data: ReadResponse }>(
     endpoints.feeds.readAll(feedId),
     { feed: feedId }
   )
-  return toDataResponse<{ ok: boolean; read: number }>(response, 'read all').data
+  return toDataResponse<ReadResponse>(response, 'read all').data
+}
+
+const readEverything = async (): Promise<ReadResponse> => {
+  const response = await client.post<{ data: ReadResponse }>(
+    endpoints.feeds.readEverything,
+    {}
+  )
+  return toDataResponse<ReadResponse>(response, 'read everything').data
 }
 
 const getRssToken = async (
@@ -903,6 +1118,28 @@ const setBanner = async (
   return toDataResponse<{ success: boolean }>(response, 'set banner')
 }
 
+// Set the feed's custom CSS and layout; returns the CSS as stored
+const setCss = async (
+  feedId: string,
+  css: string,
+  layout: FeedLayout
+): Promise<{ css: string; layout: FeedLayout }> => {
+  const response = await client.post<{ data: { css: string; layout: FeedLayout } }>(
+    endpoints.feeds.cssSet(feedId),
+    { feed: feedId, css, layout }
+  )
+  return toDataResponse<{ css: string; layout: FeedLayout }>(response, 'set css').data
+}
+
+// Hide one of the feed's notices for this user
+const dismissNotice = async (feedId: string, notice: string): Promise<boolean> => {
+  const response = await client.post<{ data: { success: boolean } }>(
+    endpoints.feeds.noticeDismiss(feedId),
+    { notice }
+  )
+  return toDataResponse<{ success: boolean }>(response, 'dismiss notice').data.success
+}
+
 const setDefaultSort = async (sort: string): Promise<void> => {
   const formData = new URLSearchParams()
   formData.append('sort', sort)
@@ -911,6 +1148,30 @@ const setDefaultSort = async (sort: string): Promise<void> => {
   })
 }
 
+const setMaskedWords = async (words: string[]): Promise<string[]> => {
+  const response = await client.post<{ data: { words: string[] } }>(
+    endpoints.feeds.maskSet,
+    { words: words.join('\n') }
+  )
+  return toDataResponse<{ words: string[] }>(response, 'set masked words').data.words
+}
+
+const setShowSensitive = async (show: boolean): Promise<boolean> => {
+  const response = await client.post<{ data: { show: boolean } }>(
+    endpoints.feeds.sensitiveSet,
+    { show: show ? '1' : '0' }
+  )
+  return toDataResponse<{ show: boolean }>(response, 'set sensitive media').data.show
+}
+
+const setShowMature = async (show: boolean): Promise<boolean> => {
+  const response = await client.post<{ data: { show: boolean } }>(
+    endpoints.feeds.matureSet,
+    { show: show ? '1' : '0' }
+  )
+  return toDataResponse<{ show: boolean }>(response, 'set mature feeds').data.show
+}
+
 const setFeedSort = async (feedId: string, sort: string): Promise<void> => {
   const formData = new URLSearchParams()
   formData.append('sort', sort)
@@ -921,6 +1182,11 @@ const setFeedSort = async (feedId: string, sort: string): Promise<void> => {
 
 export const feedsApi = {
   share: shareFeed,
+  listInvites,
+  createInvite,
+  revokeInvite,
+  setFormatting,
+  setRating,
   view: viewFeed,
   get: getFeed,
   getAll: getAllFeeds,
@@ -949,11 +1215,19 @@ export const feedsApi = {
   getAccessRules,
   setAccessLevel,
   revokeAccess,
+  getTransfers,
+  answerTransfer,
+  getRelatedPosts,
+  getHighlights,
+  highlightComment,
   searchUsers,
   searchMembers,
   listGroups,
   postsRead,
   readAll,
+  readEverything,
+  translatePost,
+  setPostSensitive,
   getRssToken,
   revokeRssToken,
   getSources,
@@ -971,6 +1245,11 @@ export const feedsApi = {
   clearNotifications,
   getBanner,
   setBanner,
+  setCss,
+  dismissNotice,
   setDefaultSort,
+  setMaskedWords,
+  setShowSensitive,
+  setShowMature,
   setFeedSort,
 }
@@ -83,6 +83,10 @@ function FeedsLayoutInner() {
       body: string
       data?: PostData
       files: File[]
+      warning?: string
+      sensitive?: boolean
+      title?: string
+      summary?: string
     }) => {
       try {
         await feedsApi.createPost({
@@ -90,6 +94,10 @@ function FeedsLayoutInner() {
           body: input.body,
           data: input.data,
           files: input.files,
+          warning: input.warning,
+          sensitive: input.sensitive,
+          title: input.title,
+          summary: input.summary,
         })
         // Invalidate TanStack Query cache (for individual feed pages)
         await queryClient.invalidateQueries({
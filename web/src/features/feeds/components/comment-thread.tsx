@@ -3,7 +3,7 @@
 // This file is part of Mochi, licensed under the GNU AGPL v3 with the
 // Mochi Application Interface Exception - see license.txt and license-exception.md.
 
-import { useCallback, useRef, useState } from 'react'
+import { Fragment, useCallback, useRef, useState } from 'react'
 import { Plural, Trans } from '@lingui/react/macro'
 import type { FeedComment, ReactionId } from '@/types'
 import {
@@ -12,8 +12,10 @@ import {
   ConfirmDialog,
   EntityAvatar,
   getAppPath,
+  getErrorMessage,
   MentionTextarea,
   renderMentions,
+  toast,
   Tooltip,
   TooltipContent,
   TooltipTrigger,
@@ -36,9 +38,13 @@ import {
   ActionPillActions,
 } from '@mochi/web'
 import endpoints from '@/api/endpoints'
-import { Check, Loader2, Paperclip, Pencil, Plus, Reply, Send, Trash2, X } from 'lucide-react'
+import { feedsApi } from '@/api/feeds'
+import { Check, Loader2, Paperclip, Pencil, Plus, Reply, Send, Star, Trash2, X } from 'lucide-react'
 import { CommentAttachments } from './comment-attachments'
+import { ContentWarning } from './content-warning'
 import { ReactionBar } from './reaction-bar'
+import { useFeedsStore } from '@/stores/feeds-store'
+import { maskText, revealMasked } from '../utils'
 import { t } from '@lingui/core/macro'
 
 type CommentThreadProps = {
@@ -83,10 +89,12 @@ export function CommentThread({
   onSearchPeople,
 }: CommentThreadProps) {
   const { formatTimestamp, formatFileSize } = useFormat()
+  const maskedWords = useFeedsStore((state) => state.maskedWords)
   const [collapsed, setCollapsed] = useState(false)
   const [editing, setEditing] = useState<string | null>(null)
   const [editBody, setEditBody] = useState('')
   const [deleting, setDeleting] = useState(false)
+  const [highlighted, setHighlighted] = useState(!!comment.highlighted)
   const [replyFiles, setReplyFiles] = useState<File[]>([])
   const [isSubmittingReply, setIsSubmittingReply] = useState(false)
   const replyPreviewUrls = useImageObjectUrls(replyFiles)
@@ -110,6 +118,17 @@ export function CommentThread({
     currentUserId && currentUserId === comment.subscriberId
   )
 
+  const handleHighlight = async () => {
+    const next = !highlighted
+    setHighlighted(next)
+    try {
+      setHighlighted(await feedsApi.highlightComment(feedId, postId, comment.id, next))
+    } catch (error) {
+      setHighlighted(!next)
+      toast.error(getErrorMessage(error, t`Failed to update highlight`))
+    }
+  }
+
   const canEditComment = isCommentOwner && onEdit
   const canDeleteComment = (isCommentOwner || canManageComments) && onDelete
 
@@ -164,15 +183,22 @@ export function CommentThread({
   )
 
   const content = (
-    <div className='space-y-2 md:space-y-1.5'>
+    <div id={`comment-${comment.id}`} className='space-y-2 md:space-y-1.5'>
       {/* Per-row hover group - only this comment's row, not children */}
       <div className='group/row'>
         <div className='flex h-5 items-center gap-2 text-xs'>
           <span className='text-foreground font-medium'>{comment.author}</span>
           <span className='text-muted-foreground'>·</span>
           <span className='text-muted-foreground'>{formatTimestamp(comment.created)}</span>
+          {highlighted && (
+            <span className='text-primary inline-flex items-center gap-1 font-medium'>
+              <Star className='size-3 fill-current' />
+              <Trans>Highlighted</Trans>
+            </span>
+          )}
         </div>
 
+        <ContentWarning warning={editing === comment.id ? undefined : comment.warning}>
         {editing === comment.id ? (
           <div className='space-y-2'>
             <MentionTextarea
@@ -216,11 +242,20 @@ export function CommentThread({
           </div>
         ) : (
           <p className='text-foreground text-sm leading-relaxed whitespace-pre-wrap'>
-            {renderMentions(comment.body)}
+            {maskText(comment.body, maskedWords).map((part, i) =>
+              part.masked ? (
+                <span key={i} className='masked' onClick={revealMasked}>
+                  {part.text}
+                </span>
+              ) : (
+                <Fragment key={i}>{renderMentions(part.text)}</Fragment>
+              )
+            )}
           </p>
         )}
 
         <CommentAttachments attachments={comment.attachments} />
+        </ContentWarning>
 
         {(() => {
           const hasReactions = !!(
@@ -294,6 +329,21 @@ export function CommentThread({
                       <TooltipContent>{t`Edit comment`}</TooltipContent>
                     </Tooltip>
                   )}
+                  {canManageComments && (
+                    <Tooltip>
+                      <TooltipTrigger asChild>
+                        <button
+                          type='button'
+                          aria-label={highlighted ? t`Remove highlight` : t`Highlight comment`}
+                          className={iconActionButtonClass}
+                          onClick={() => void handleHighlight()}
+                        >
+                          <Star className={highlighted ? 'size-4 fill-current' : 'size-4'} />
+                        </button>
+                      </TooltipTrigger>
+                      <TooltipContent>{highlighted ? t`Remove highlight` : t`Highlight comment`}</TooltipContent>
+                    </Tooltip>
+                  )}
                   {canDeleteComment && (
                     <Tooltip>
                       <TooltipTrigger asChild>
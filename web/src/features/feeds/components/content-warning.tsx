@@ -0,0 +1,46 @@
+// Copyright © 2026 Mochisoft OÜ
+// SPDX-License-Identifier: AGPL-3.0-only
+// This file is part of Mochi, licensed under the GNU AGPL v3 with the
+// Mochi Application Interface Exception - see license.txt and license-exception.md.
+
+import { useState, type ReactNode } from 'react'
+import { TriangleAlert } from 'lucide-react'
+import { Trans } from '@lingui/react/macro'
+import { Button } from '@mochi/web'
+
+interface ContentWarningProps {
+  warning?: string
+  children: ReactNode
+}
+
+/**
+ * Hide a post or comment behind its author's content warning until the reader
+ * opens it. Without a warning the children render as they are.
+ */
+export function ContentWarning({ warning, children }: ContentWarningProps) {
+  const [open, setOpen] = useState(false)
+
+  if (!warning) return <>{children}</>
+
+  return (
+    <>
+      <div className='bg-muted/50 flex items-center gap-2 rounded-[8px] border px-3 py-2 text-sm'>
+        <TriangleAlert className='text-muted-foreground size-4 shrink-0' />
+        <span className='min-w-0 flex-1 font-medium break-words'>{warning}</span>
+        <Button
+          type='button'
+          variant='ghost'
+          size='sm'
+          className='h-7 shrink-0'
+          onClick={(e) => {
+            e.stopPropagation()
+            setOpen(!open)
+          }}
+        >
+          {open ? <Trans>Hide</Trans> : <Trans>Show</Trans>}
+        </Button>
+      </div>
+      {open && children}
+    </>
+  )
+}
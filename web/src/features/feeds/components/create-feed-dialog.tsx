@@ -40,6 +40,7 @@ export function CreateFeedDialog({
           name: values.name,
           privacy: values.privacy ?? 'public',
           memories: values.toggles?.memories !== false,
+          rating: values.toggles?.mature ? 'mature' : '',
         }),
         {
           loading: t`Creating feed...`,
@@ -76,6 +77,11 @@ export function CreateFeedDialog({
           label: t`Enable memories`,
           defaultValue: true,
         },
+        {
+          name: 'mature',
+          label: t`Mature content`,
+          defaultValue: false,
+        },
       ]}
       onSubmit={handleSubmit}
       isPending={isPending}
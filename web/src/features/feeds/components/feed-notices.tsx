@@ -0,0 +1,61 @@
+// Copyright © 2026 Mochisoft OÜ
+// SPDX-License-Identifier: AGPL-3.0-only
+// This file is part of Mochi, licensed under the GNU AGPL v3 with the
+// Mochi Application Interface Exception - see license.txt and license-exception.md.
+
+import { useState } from 'react'
+import { Megaphone, X } from 'lucide-react'
+import { getErrorMessage, toast, Tooltip, TooltipContent, TooltipTrigger } from '@mochi/web'
+import { t } from '@lingui/core/macro'
+import { feedsApi } from '@/api/feeds'
+import type { FeedNotice } from '@/types'
+
+interface FeedNoticesProps {
+  notices: FeedNotice[]
+  feedId: string
+}
+
+// Notices from the feed's owner, shown above the posts until they expire or
+// the user dismisses them
+export function FeedNotices({ notices, feedId }: FeedNoticesProps) {
+  const [dismissed, setDismissed] = useState<string[]>([])
+  const visible = notices.filter((notice) => !dismissed.includes(notice.id))
+
+  if (visible.length === 0) return null
+
+  const handleDismiss = (id: string) => {
+    setDismissed((prev) => [...prev, id])
+    feedsApi.dismissNotice(feedId, id).catch((error) => {
+      setDismissed((prev) => prev.filter((d) => d !== id))
+      toast.error(getErrorMessage(error, t`Failed to dismiss notice`))
+    })
+  }
+
+  return (
+    <div className="mb-4 space-y-2">
+      {visible.map((notice) => (
+        <div
+          key={notice.id}
+          role="status"
+          className="relative flex gap-3 rounded-lg border border-primary/40 bg-primary/5 px-4 py-3"
+        >
+          <Megaphone className="mt-0.5 size-4 shrink-0 text-primary" />
+          <p className="pe-6 text-sm leading-relaxed whitespace-pre-wrap">{notice.body}</p>
+          <Tooltip>
+            <TooltipTrigger asChild>
+              <button
+                type="button"
+                onClick={() => handleDismiss(notice.id)}
+                className="absolute right-2 top-2 rounded-sm p-1 text-muted-foreground hover:text-foreground"
+                aria-label={t`Dismiss notice`}
+              >
+                <X className="size-3.5" />
+              </button>
+            </TooltipTrigger>
+            <TooltipContent>{t`Dismiss notice`}</TooltipContent>
+          </Tooltip>
+        </div>
+      ))}
+    </div>
+  )
+}
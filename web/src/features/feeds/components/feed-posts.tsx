@@ -43,9 +43,12 @@ import {
   ActionPill,
   ActionPillSticky,
   ActionPillActions,
+  toast,
+  getErrorMessage,
 } from '@mochi/web'
 import {
   Check,
+  EyeOff,
   MapPin,
   MessageSquare,
   MoreHorizontal,
@@ -59,7 +62,8 @@ import {
 
 import { Trans } from '@lingui/react/macro'
 import { feedsApi } from '@/api/feeds'
-import { sanitizeHtml, linkifyText, embedVideos, stripImages, stripEllipsis, extractImgAttrs, stripHtml, safeHref } from '../utils'
+import { useFeedsStore } from '@/stores/feeds-store'
+import { sanitizeHtml, linkifyText, embedVideos, stripImages, stripEllipsis, extractImgAttrs, stripHtml, safeHref, maskHtml, maskText, revealMasked } from '../utils'
 import {
   buildFeedPostEditDraft,
   feedPostEditOriginalFromPost,
@@ -67,6 +71,7 @@ import {
   type FeedPostEditOriginal,
 } from '../edit-compare'
 import { CommentThread } from './comment-thread'
+import { ContentWarning } from './content-warning'
 import { SavedButton } from './saved-button'
 import { PostAttachments } from './post-attachments'
 import { PostTagsTooltip } from './post-tags'
@@ -307,6 +312,7 @@ export function FeedPosts({
   isFetchingNextPage = false,
 }: FeedPostsProps) {
   const { formatTimestamp, formatFileSize } = useFormat()
+  const maskedWords = useFeedsStore((state) => state.maskedWords)
   const [listRef] = useListAutoAnimate<HTMLDivElement>({
     disabled: isFetchingNextPage,
   })
@@ -450,6 +456,7 @@ export function FeedPosts({
               </span>
 
               <div className='space-y-3'>
+                <ContentWarning warning={editingPost?.id === post.id ? undefined : post.warning}>
                 {/* Post body - show edit form if editing */}
                 {editingPost?.id === post.id ? (
                   <div className='space-y-3'>
@@ -760,8 +767,21 @@ export function FeedPosts({
                       </div>
                     </div>
                   </div>
-                ) : (post.body.trim() || hasRssTitle) ? (
+                ) : (post.body.trim() || hasRssTitle || post.title) ? (
                   <>
+                    {post.title && !hasRssTitle && (
+                      <h2 className='text-lg font-semibold'>
+                        {maskText(post.title, maskedWords).map((part, i) =>
+                          part.masked ? (
+                            <span key={i} className='masked' onClick={revealMasked}>
+                              {part.text}
+                            </span>
+                          ) : (
+                            part.text
+                          )
+                        )}
+                      </h2>
+                    )}
                     {hasRssTitle && (
                       <div>
                         <a
@@ -803,6 +823,22 @@ export function FeedPosts({
                       />
                     )}
                     {(() => {
+                      // Lists show a post's summary, if it has one, in place of the body
+                      if (!singlePost && post.summary) {
+                        return (
+                          <p className='text-foreground text-sm leading-relaxed whitespace-pre-wrap'>
+                            {maskText(post.summary, maskedWords).map((part, i) =>
+                              part.masked ? (
+                                <span key={i} className='masked' onClick={revealMasked}>
+                                  {part.text}
+                                </span>
+                              ) : (
+                                part.text
+                              )
+                            )}
+                          </p>
+                        )
+                      }
                       const rawHtml = !singlePost && post.data?.rss
                         ? stripEllipsis(stripImages(post.bodyHtml ? sanitizeHtml(post.bodyHtml) : sanitizeHtml(linkifyText(post.body))))
                         : (post.bodyHtml ? sanitizeHtml(post.bodyHtml) : sanitizeHtml(linkifyText(post.body)))
@@ -816,7 +852,8 @@ export function FeedPosts({
                           {(hasText || hasImages) && (
                             <div
                               className={`prose prose-sm dark:prose-invert max-w-none text-foreground prose-p:my-3 prose-p:leading-relaxed prose-ul:my-3 prose-ul:list-disc prose-ul:ps-6 prose-ul:marker:text-foreground prose-ol:my-3 prose-ol:list-decimal prose-ol:ps-6 prose-ol:marker:text-foreground prose-li:my-1 [&>*:first-child]:mt-0 [&>*:last-child]:mb-0 [&_table]:w-full [&_table]:border-collapse [&_table]:my-3 [&_th]:border [&_th]:border-border [&_th]:px-3 [&_th]:py-2 [&_th]:text-start [&_th]:font-semibold [&_td]:border [&_td]:border-border [&_td]:px-3 [&_td]:py-2 ${!post.bodyHtml && !post.data?.rss ? 'whitespace-pre-wrap' : ''} ${!singlePost && post.data?.rss ? 'line-clamp-6' : ''}`}
-                              dangerouslySetInnerHTML={{ __html: embedVideos(rawHtml) }}
+                              onClick={revealMasked}
+                              dangerouslySetInnerHTML={{ __html: embedVideos(maskHtml(rawHtml, maskedWords)) }}
                             />
                           )}
                           {imgAltText && (
@@ -892,10 +929,12 @@ export function FeedPosts({
                           feedId={post.feedFingerprint ?? post.feedId}
                           inline
                           mediaCap={8 - (post.data?.checkin ? 1 : 0) - (post.data?.travelling ? 1 : 0)}
+                          sensitive={post.sensitive}
                         />
                       )}
                     </div>
                   )}
+                </ContentWarning>
 
                 {/* Actions row - always visible */}
                 {/* For aggregate view (usePerPostPermissions), check post.permissions; otherwise use component permissions */}
@@ -1061,6 +1100,20 @@ export function FeedPosts({
                                       <Pencil className='me-2 size-4' />
                                       <Trans>Edit post</Trans>
                                     </DropdownMenuItem>
+                                    {post.attachments && post.attachments.length > 0 && (
+                                      <DropdownMenuItem
+                                        onClick={(e) => {
+                                          e.preventDefault()
+                                          e.stopPropagation()
+                                          feedsApi
+                                            .setPostSensitive(post.feedFingerprint ?? post.feedId, post.id, !post.sensitive)
+                                            .catch((error) => toast.error(getErrorMessage(error, t`Failed to update post`)))
+                                        }}
+                                      >
+                                        <EyeOff className='me-2 size-4' />
+                                        {post.sensitive ? <Trans>Unmark media sensitive</Trans> : <Trans>Mark media sensitive</Trans>}
+                                      </DropdownMenuItem>
+                                    )}
                                     <DropdownMenuItem
                                       onClick={(e) => {
                                         e.preventDefault()
@@ -52,7 +52,7 @@ export function InlineFeedSearch({ subscribedIds, onRefresh }: InlineFeedSearchP
         setResults(data?.id
           ? [{ id: data.id, name: data.name ?? '', fingerprint: data.fingerprint ?? '',
                fingerprint_hyphens: '', class: 'feed', created: 0,
-               location: data.server ?? '', peer: data.peer }]
+               location: data.server ?? '', peer: data.peer, invite: data.invite }]
           : [])
         return
       }
@@ -96,7 +96,7 @@ export function InlineFeedSearch({ subscribedIds, onRefresh }: InlineFeedSearchP
   const handleSubscribe = async (feed: DirectoryEntry) => {
     setPendingFeedId(feed.id)
     try {
-      await toastAction(feedsApi.subscribe(feed.id, feed.location || undefined, feed.peer), {
+      await toastAction(feedsApi.subscribe(feed.id, feed.location || undefined, feed.peer, feed.invite), {
         loading: t`Subscribing...`,
         success: t`Subscribed`,
         error: (e) => getErrorMessage(e, t`Failed to subscribe`),
@@ -166,7 +166,14 @@ export function InlineFeedSearch({ subscribedIds, onRefresh }: InlineFeedSearchP
                       <Rss className="h-4 w-4 text-orange-600" />
                     </div>
                     <div className="flex min-w-0 flex-1 flex-col text-start">
-                      <span className="truncate text-sm font-medium">{feed.name}</span>
+                      <span className="flex min-w-0 items-center gap-1.5">
+                        <span className="truncate text-sm font-medium">{feed.name}</span>
+                        {feed.rating === 'mature' && (
+                          <span className="text-muted-foreground shrink-0 rounded border px-1 text-[10px] font-medium uppercase">
+                            <Trans>Mature</Trans>
+                          </span>
+                        )}
+                      </span>
                       {feed.fingerprint && (
                         <span className="text-muted-foreground truncate text-xs">
                           {feed.fingerprint.match(/.{1,3}/g)?.join('-')}
@@ -7,7 +7,9 @@ import { useEffect, useState, useRef, useCallback } from 'react'
 import { Trans, useLingui } from '@lingui/react/macro'
 import {
   Button,
+  Input,
   Label,
+  Textarea,
   MapView,
   MentionTextarea,
   PlacePicker,
@@ -51,11 +53,14 @@ import {
   FilePlus2,
   Loader2,
   Send,
+  TriangleAlert,
+  EyeOff,
+  Heading,
 } from 'lucide-react'
 
 type NewPostDialogProps = {
   feeds: FeedSummary[]
-  onSubmit: (input: { feedId: string; body: string; data?: PostData; files: File[] }) => void | Promise<void>
+  onSubmit: (input: { feedId: string; body: string; data?: PostData; files: File[]; warning?: string; sensitive?: boolean; title?: string; summary?: string }) => void | Promise<void>
   /** Controlled open state */
   open?: boolean
   /** Callback when open state changes */
@@ -71,6 +76,11 @@ type NewPostFormState = {
   body: string
   data: PostData
   files: File[]
+  warning?: string
+  sensitive?: boolean
+  // Undefined until the author adds a title; the summary goes with it
+  title?: string
+  summary?: string
 }
 
 type PlacePickerMode = 'checkin' | null
@@ -219,8 +229,12 @@ export function NewPostDialog({ feeds, onSubmit, open, onOpenChange, hideTrigger
         body: form.body,
         data: hasData ? cleanData : undefined,
         files: form.files,
+        warning: form.warning?.trim() || undefined,
+        sensitive: form.files.length > 0 && form.sensitive ? true : undefined,
+        title: form.title?.trim() || undefined,
+        summary: form.summary?.trim() || undefined,
       })
-      setForm((prev) => ({ ...prev, body: '', data: {}, files: [] }))
+      setForm((prev) => ({ ...prev, body: '', data: {}, files: [], warning: undefined, sensitive: false, title: undefined, summary: undefined }))
       setIsOpen(false)
     } finally {
       setIsSubmitting(false)
@@ -276,6 +290,37 @@ export function NewPostDialog({ feeds, onSubmit, open, onOpenChange, hideTrigger
               </Select>
             </div>
           )}
+          {form.title !== undefined && (
+            <div className='space-y-2'>
+              <div className='flex items-center justify-between'>
+                <Label htmlFor='legacy-post-title'><Trans>Title</Trans></Label>
+                <Button
+                  type='button'
+                  variant='ghost'
+                  size='icon'
+                  className='size-6'
+                  onClick={() => setForm((prev) => ({ ...prev, title: undefined, summary: undefined }))}
+                  aria-label={t`Remove title`}
+                >
+                  <X className='size-4' />
+                </Button>
+              </div>
+              <Input
+                id='legacy-post-title'
+                maxLength={200}
+                value={form.title}
+                onChange={(e) => setForm((prev) => ({ ...prev, title: e.target.value }))}
+              />
+              <Textarea
+                id='legacy-post-summary'
+                rows={2}
+                maxLength={500}
+                placeholder={t`Summary, shown in lists in place of the post`}
+                value={form.summary ?? ''}
+                onChange={(e) => setForm((prev) => ({ ...prev, summary: e.target.value }))}
+              />
+            </div>
+          )}
           <div className='space-y-2'>
             <Label htmlFor='legacy-post-body'><Trans>Post content</Trans></Label>
             <MentionTextarea
@@ -289,6 +334,31 @@ export function NewPostDialog({ feeds, onSubmit, open, onOpenChange, hideTrigger
             />
           </div>
 
+          {form.warning !== undefined && (
+            <div className='space-y-2'>
+              <div className='flex items-center justify-between'>
+                <Label htmlFor='legacy-post-warning'><Trans>Content warning</Trans></Label>
+                <Button
+                  type='button'
+                  variant='ghost'
+                  size='icon'
+                  className='size-6'
+                  onClick={() => setForm((prev) => ({ ...prev, warning: undefined }))}
+                  aria-label={t`Remove content warning`}
+                >
+                  <X className='size-4' />
+                </Button>
+              </div>
+              <Input
+                id='legacy-post-warning'
+                maxLength={200}
+                placeholder={t`Shown in place of the post until opened`}
+                value={form.warning}
+                onChange={(e) => setForm((prev) => ({ ...prev, warning: e.target.value }))}
+              />
+            </div>
+          )}
+
           {/* Location display */}
           {(form.data.checkin || form.data.travelling) && (
             <div className='space-y-2'>
@@ -382,6 +452,40 @@ export function NewPostDialog({ feeds, onSubmit, open, onOpenChange, hideTrigger
               <Plane className='size-4' />
               <Trans>Travelling</Trans>
             </Button>
+            {form.title === undefined && (
+              <Button
+                type='button'
+                variant='outline'
+                size='sm'
+                onClick={() => setForm((prev) => ({ ...prev, title: '' }))}
+              >
+                <Heading className='size-4' />
+                <Trans>Title</Trans>
+              </Button>
+            )}
+            {form.warning === undefined && (
+              <Button
+                type='button'
+                variant='outline'
+                size='sm'
+                onClick={() => setForm((prev) => ({ ...prev, warning: '' }))}
+              >
+                <TriangleAlert className='size-4' />
+                <Trans>Content warning</Trans>
+              </Button>
+            )}
+            {form.files.length > 0 && (
+              <Button
+                type='button'
+                variant={form.sensitive ? 'secondary' : 'outline'}
+                size='sm'
+                aria-pressed={!!form.sensitive}
+                onClick={() => setForm((prev) => ({ ...prev, sensitive: !prev.sensitive }))}
+              >
+                <EyeOff className='size-4' />
+                <Trans>Sensitive media</Trans>
+              </Button>
+            )}
           </div>
 
           {/* Attachments */}
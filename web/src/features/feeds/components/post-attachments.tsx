@@ -3,20 +3,27 @@
 // This file is part of Mochi, licensed under the GNU AGPL v3 with the
 // Mochi Application Interface Exception - see license.txt and license-exception.md.
 
+import { useState } from 'react'
+import { EyeOff } from 'lucide-react'
+import { Trans } from '@lingui/react/macro'
 import { AttachmentGallery, authenticatedUrl, getAppPath, normalizeEntityUrl } from '@mochi/web'
 import type { Attachment } from '@/types'
+import { useFeedsStore } from '@/stores/feeds-store'
 
 type PostAttachmentsProps = {
   attachments: Attachment[]
   feedId: string
   inline?: boolean
   mediaCap?: number
+  sensitive?: boolean
 }
 
-export function PostAttachments({ attachments, feedId, inline = false, mediaCap = 8 }: PostAttachmentsProps) {
+export function PostAttachments({ attachments, feedId, inline = false, mediaCap = 8, sensitive = false }: PostAttachmentsProps) {
   const appPath = getAppPath()
+  const showSensitive = useFeedsStore((state) => state.showSensitive)
+  const [revealed, setRevealed] = useState(false)
 
-  return (
+  const gallery = (
     <AttachmentGallery
       attachments={attachments}
       getUrl={(att) =>
@@ -36,4 +43,30 @@ export function PostAttachments({ attachments, feedId, inline = false, mediaCap
       mediaCap={mediaCap}
     />
   )
+
+  if (!sensitive || showSensitive || revealed) return gallery
+
+  // Media marked sensitive stays blurred, and can't be opened, until clicked
+  return (
+    <div className='relative overflow-hidden rounded-[8px]'>
+      <div className='pointer-events-none blur-2xl select-none' aria-hidden>
+        {gallery}
+      </div>
+      <button
+        type='button'
+        className='bg-background/40 text-foreground absolute inset-0 flex flex-col items-center justify-center gap-1 text-sm font-medium'
+        onClick={(e) => {
+          e.preventDefault()
+          e.stopPropagation()
+          setRevealed(true)
+        }}
+      >
+        <EyeOff className='size-5' />
+        <Trans>Sensitive media</Trans>
+        <span className='text-muted-foreground text-xs font-normal'>
+          <Trans>Click to show</Trans>
+        </span>
+      </button>
+    </div>
+  )
 }
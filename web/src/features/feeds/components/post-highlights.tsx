@@ -0,0 +1,62 @@
+// Copyright © 2026 Mochisoft OÜ
+// SPDX-License-Identifier: AGPL-3.0-only
+// This file is part of Mochi, licensed under the GNU AGPL v3 with the
+// Mochi Application Interface Exception - see license.txt and license-exception.md.
+
+import { useQuery } from '@tanstack/react-query'
+import { Trans } from '@lingui/react/macro'
+import { Star } from 'lucide-react'
+import { useFormat } from '@mochi/web'
+import { feedsApi } from '@/api/feeds'
+import { useFeedsStore } from '@/stores/feeds-store'
+import { maskText, revealMasked } from '../utils'
+
+interface PostHighlightsProps {
+  feedId: string
+  postId: string
+}
+
+// Comments on a post that the feed's owner highlighted, gathered in one place
+export function PostHighlights({ feedId, postId }: PostHighlightsProps) {
+  const { formatTimestamp } = useFormat()
+  const maskedWords = useFeedsStore((state) => state.maskedWords)
+  const { data: comments = [] } = useQuery({
+    queryKey: ['feeds', 'highlights', feedId, postId],
+    queryFn: () => feedsApi.getHighlights(feedId, postId),
+    retry: false,
+  })
+
+  if (comments.length === 0) return null
+
+  return (
+    <section className='space-y-2 pt-2'>
+      <h3 className='text-muted-foreground inline-flex items-center gap-1.5 text-sm font-medium'>
+        <Star className='text-primary size-3.5 fill-current' />
+        <Trans>Highlights</Trans>
+      </h3>
+      <ul className='divide-y rounded-lg border'>
+        {comments.map((comment) => (
+          <li key={comment.id}>
+            <a href={`#comment-${comment.id}`} className='hover:bg-hover block space-y-1 px-4 py-3'>
+              <span className='text-xs'>
+                <span className='font-medium'>{comment.name}</span>
+                <span className='text-muted-foreground'> · {formatTimestamp(comment.created)}</span>
+              </span>
+              <p className='line-clamp-3 text-sm whitespace-pre-wrap'>
+                {maskText(comment.body, maskedWords).map((part, i) =>
+                  part.masked ? (
+                    <span key={i} className='masked' onClick={revealMasked}>
+                      {part.text}
+                    </span>
+                  ) : (
+                    part.text
+                  )
+                )}
+              </p>
+            </a>
+          </li>
+        ))}
+      </ul>
+    </section>
+  )
+}
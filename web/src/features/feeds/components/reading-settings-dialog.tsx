@@ -0,0 +1,129 @@
+// Copyright © 2026 Mochisoft OÜ
+// SPDX-License-Identifier: AGPL-3.0-only
+// This file is part of Mochi, licensed under the GNU AGPL v3 with the
+// Mochi Application Interface Exception - see license.txt and license-exception.md.
+
+import { useEffect, useState } from 'react'
+import { Loader2 } from 'lucide-react'
+import { Trans, useLingui } from '@lingui/react/macro'
+import {
+  Button,
+  Label,
+  ResponsiveDialog,
+  ResponsiveDialogClose,
+  ResponsiveDialogContent,
+  ResponsiveDialogFooter,
+  ResponsiveDialogHeader,
+  ResponsiveDialogTitle,
+  Select,
+  SelectContent,
+  SelectItem,
+  SelectTrigger,
+  SelectValue,
+  Textarea,
+  getErrorMessage,
+  toast,
+} from '@mochi/web'
+import { useFeedsStore } from '@/stores/feeds-store'
+
+interface ReadingSettingsDialogProps {
+  open: boolean
+  onOpenChange: (open: boolean) => void
+}
+
+// The user's own reading preferences, which apply across every feed
+export function ReadingSettingsDialog({ open, onOpenChange }: ReadingSettingsDialogProps) {
+  const { t } = useLingui()
+  const maskedWords = useFeedsStore((state) => state.maskedWords)
+  const setMaskedWords = useFeedsStore((state) => state.setMaskedWords)
+  const showSensitive = useFeedsStore((state) => state.showSensitive)
+  const setShowSensitive = useFeedsStore((state) => state.setShowSensitive)
+  const showMature = useFeedsStore((state) => state.showMature)
+  const setShowMature = useFeedsStore((state) => state.setShowMature)
+  const [words, setWords] = useState('')
+  const [sensitive, setSensitive] = useState(false)
+  const [mature, setMature] = useState(false)
+  const [saving, setSaving] = useState(false)
+
+  useEffect(() => {
+    if (!open) return
+    setWords(maskedWords.join('\n'))
+    setSensitive(showSensitive)
+    setMature(showMature)
+  }, [open, maskedWords, showSensitive, showMature])
+
+  const handleSave = async () => {
+    setSaving(true)
+    try {
+      await setMaskedWords(words.split(/[\n,]/).map((w) => w.trim()).filter(Boolean))
+      if (sensitive !== showSensitive) await setShowSensitive(sensitive)
+      if (mature !== showMature) await setShowMature(mature)
+      toast.success(t`Reading settings updated`)
+      onOpenChange(false)
+    } catch (error) {
+      toast.error(getErrorMessage(error, t`Failed to update reading settings`))
+    } finally {
+      setSaving(false)
+    }
+  }
+
+  return (
+    <ResponsiveDialog open={open} onOpenChange={onOpenChange}>
+      <ResponsiveDialogContent className='sm:max-w-[480px]'>
+        <ResponsiveDialogHeader>
+          <ResponsiveDialogTitle><Trans>Reading settings</Trans></ResponsiveDialogTitle>
+        </ResponsiveDialogHeader>
+        <div className='space-y-4'>
+          <div className='space-y-2'>
+            <Label htmlFor='reading-masked-words'><Trans>Masked words</Trans></Label>
+            <Textarea
+              id='reading-masked-words'
+              value={words}
+              onChange={(e) => setWords(e.target.value)}
+              placeholder={t`One word or phrase per line`}
+              rows={5}
+            />
+            <p className='text-muted-foreground text-xs'>
+              <Trans>Hidden in posts and comments until you click them.</Trans>
+            </p>
+          </div>
+          <div className='space-y-2'>
+            <Label htmlFor='reading-sensitive'><Trans>Sensitive media</Trans></Label>
+            <Select value={sensitive ? 'show' : 'blur'} onValueChange={(value) => setSensitive(value === 'show')}>
+              <SelectTrigger id='reading-sensitive' className='w-48'>
+                <SelectValue />
+              </SelectTrigger>
+              <SelectContent>
+                <SelectItem value='blur'><Trans>Blur until clicked</Trans></SelectItem>
+                <SelectItem value='show'><Trans>Always show</Trans></SelectItem>
+              </SelectContent>
+            </Select>
+          </div>
+          <div className='space-y-2'>
+            <Label htmlFor='reading-mature'><Trans>Mature feeds in search</Trans></Label>
+            <Select value={mature ? 'show' : 'hide'} onValueChange={(value) => setMature(value === 'show')}>
+              <SelectTrigger id='reading-mature' className='w-48'>
+                <SelectValue />
+              </SelectTrigger>
+              <SelectContent>
+                <SelectItem value='hide'><Trans>Hide</Trans></SelectItem>
+                <SelectItem value='show'><Trans>Show</Trans></SelectItem>
+              </SelectContent>
+            </Select>
+          </div>
+        </div>
+        <ResponsiveDialogFooter className='gap-2 pt-4'>
+          <ResponsiveDialogClose asChild>
+            <Button type='button' variant='outline' disabled={saving}>
+              <Trans>Cancel</Trans>
+            </Button>
+          </ResponsiveDialogClose>
+          <Button onClick={() => void handleSave()} disabled={saving}>
+            {saving && <Loader2 className='me-2 size-4 animate-spin' />}
+            <Trans>Save</Trans>
+          </Button>
+        </ResponsiveDialogFooter>
+      </ResponsiveDialogContent>
+    </ResponsiveDialog>
+  )
+}
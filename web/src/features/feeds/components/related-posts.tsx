@@ -0,0 +1,62 @@
+// Copyright © 2026 Mochisoft OÜ
+// SPDX-License-Identifier: AGPL-3.0-only
+// This file is part of Mochi, licensed under the GNU AGPL v3 with the
+// Mochi Application Interface Exception - see license.txt and license-exception.md.
+
+import { Link } from '@tanstack/react-router'
+import { useQuery } from '@tanstack/react-query'
+import { Trans } from '@lingui/react/macro'
+import { useFormat } from '@mochi/web'
+import { feedsApi } from '@/api/feeds'
+import { useFeedsStore } from '@/stores/feeds-store'
+import { maskText, revealMasked, stripHtml } from '../utils'
+
+interface RelatedPostsProps {
+  feedId: string
+  postId: string
+}
+
+// "More from this feed": posts sharing tags or wording with the one shown
+export function RelatedPosts({ feedId, postId }: RelatedPostsProps) {
+  const { formatTimestamp } = useFormat()
+  const maskedWords = useFeedsStore((state) => state.maskedWords)
+  const { data: posts = [] } = useQuery({
+    queryKey: ['feeds', 'related', feedId, postId],
+    queryFn: () => feedsApi.getRelatedPosts(feedId, postId),
+    retry: false,
+  })
+
+  if (posts.length === 0) return null
+
+  return (
+    <section className='space-y-2 pt-2'>
+      <h3 className='text-muted-foreground text-sm font-medium'>
+        <Trans>More from this feed</Trans>
+      </h3>
+      <ul className='divide-y rounded-lg border'>
+        {posts.map((related) => (
+          <li key={related.id}>
+            <Link
+              to='/$feedId/$postId'
+              params={{ feedId, postId: related.id }}
+              className='hover:bg-hover block px-4 py-3'
+            >
+              <p className='line-clamp-2 text-sm'>
+                {maskText(stripHtml(related.body), maskedWords).map((part, i) =>
+                  part.masked ? (
+                    <span key={i} className='masked' onClick={revealMasked}>
+                      {part.text}
+                    </span>
+                  ) : (
+                    part.text
+                  )
+                )}
+              </p>
+              <span className='text-muted-foreground text-xs'>{formatTimestamp(related.created)}</span>
+            </Link>
+          </li>
+        ))}
+      </ul>
+    </section>
+  )
+}
@@ -42,6 +42,7 @@ import {
   DropdownMenuSeparator,
   DropdownMenuTrigger,
   ConfirmDialog,
+  getAppPath,
 } from '@mochi/web'
 import {
   ArrowRight,
@@ -56,15 +57,23 @@ import {
   X,
 } from 'lucide-react'
 import { mapFeedsToSummaries } from '@/api/adapters'
+import endpoints from '@/api/endpoints'
 import { feedsApi } from '@/api/feeds'
 
 import { useSidebarContext } from '@/context/sidebar-context'
 import { useFeedsStore } from '@/stores/feeds-store'
 import { OptionsMenu } from '@/components/options-menu'
 import { FeedBanner } from '../components/feed-banner'
+import { FeedNotices } from '../components/feed-notices'
 import { FeedPosts } from '../components/feed-posts'
 import { usePostHandlers } from '../hooks'
 
+// Width classes for the owner's chosen layout; the default is full width
+const FEED_LAYOUT_CLASSES: Record<string, string> = {
+  narrow: 'mx-auto w-full max-w-2xl',
+  wide: 'mx-auto w-full max-w-5xl',
+}
+
 interface EntityFeedPageProps {
   feed: Feed
   permissions?: FeedPermissions
@@ -115,6 +124,7 @@ export function EntityFeedPage({
   const {
     posts: infinitePosts,
     permissions,
+    notices,
     hasAi,
     isLoading: isLoadingPosts,
     error,
@@ -173,6 +183,17 @@ export function EntityFeedPage({
   // Set page title to feed name
   usePageTitle(feedSummary.name)
 
+  // Load the owner's custom stylesheet while the feed is open. The server
+  // scopes its rules to the .feed-custom container below.
+  useEffect(() => {
+    if (!feed.css) return
+    const link = document.createElement('link')
+    link.rel = 'stylesheet'
+    link.href = `${getAppPath()}/${endpoints.feeds.css(feed.id)}`
+    document.head.appendChild(link)
+    return () => link.remove()
+  }, [feed.id, feed.css])
+
   // Register with sidebar context
   const { setFeedId, openNewPostDialog } = useSidebarContext()
   useEffect(() => {
@@ -227,6 +248,9 @@ export function EntityFeedPage({
     const timer = setInterval(() => void router.invalidate(), 3000)
     return () => clearInterval(timer)
   }, [feed.populated, router])
+  const syncPercent = feed.backfill_total
+    ? Math.floor(((feed.backfill_sent ?? 0) * 100) / feed.backfill_total)
+    : 0
 
   // Standardized actions
   const { handlePostReaction } = usePostActions({
@@ -541,7 +565,7 @@ export function EntityFeedPage({
         }
       />
       <Main fixed>
-        <div ref={scrollRef} className='flex-1 overflow-y-auto px-2 md:px-0'>
+        <div ref={scrollRef} className={`feed-custom flex-1 overflow-y-auto px-2 md:px-0 ${FEED_LAYOUT_CLASSES[feed.layout ?? ''] ?? ''}`}>
           <NewItemsPill
             count={newPosts.count}
             onClick={handleShowNewPosts}
@@ -552,6 +576,14 @@ export function EntityFeedPage({
           {feed.banner_html && (
             <FeedBanner bannerHtml={feed.banner_html} feedId={feed.id} />
           )}
+          <FeedNotices notices={notices} feedId={feed.id} />
+          {feed.populated === 0 && !!feed.backfill_total && (
+            // The owner is still sending history; say how far it has got so a
+            // partly filled feed doesn't look like the whole of it
+            <p className='text-muted-foreground py-2 text-center text-sm'>
+              <Trans>Syncing history… {syncPercent}%</Trans>
+            </p>
+          )}
           {feed.populated === 0 && currentPosts.length === 0 ? (
             // Freshly subscribed and still syncing posts over P2P, with nothing
             // to show yet: show the explicit "loading content" message, not
@@ -47,6 +47,7 @@ import { OptionsMenu } from '@/components/options-menu'
 import { FeedPosts } from '../components/feed-posts'
 import { RecommendedFeeds } from '../components/recommended-feeds'
 import { InlineFeedSearch } from '../components/inline-feed-search'
+import { ReadingSettingsDialog } from '../components/reading-settings-dialog'
 import { usePostHandlers } from '../hooks'
 import { useFeedsStore } from '@/stores/feeds-store'
 
@@ -68,6 +69,7 @@ export function FeedsListPage({
   const [postsByFeed, setPostsByFeed] = useState<Record<string, FeedPost[]>>({})
   const [commentDrafts, setCommentDrafts] = useState<Record<string, string>>({})
   const [subscriptionErrorMessage, setSubscriptionErrorMessage] = useState<string | null>(null)
+  const [readingSettingsOpen, setReadingSettingsOpen] = useState(false)
   const isLoggedIn = useAuthStore((state) => state.isAuthenticated)
   const currentUserId = useAuthStore((state) => state.identity)
   const currentUserName = useAuthStore((state) => state.name)
@@ -530,8 +532,9 @@ export function FeedsListPage({
             {isLoggedIn && <SortSelector value={sort} onValueChange={setSort} options={sortOptions} />}
           </>
         }
-        menuAction={<OptionsMenu showRss />}
+        menuAction={<OptionsMenu showRss onSettings={isLoggedIn ? () => setReadingSettingsOpen(true) : undefined} />}
       />
+      <ReadingSettingsDialog open={readingSettingsOpen} onOpenChange={setReadingSettingsOpen} />
       <Main fixed>
         <div ref={scrollRef} className='flex flex-1 flex-col gap-4 overflow-y-auto'>
           <NewItemsPill
@@ -3,6 +3,7 @@
 // This file is part of Mochi, licensed under the GNU AGPL v3 with the
 // Mochi Application Interface Exception - see license.txt and license-exception.md.
 
+import type { MouseEvent } from 'react'
 import type { FeedComment, ReactionCounts, ReactionId } from '@/types'
 import DOMPurify from 'dompurify'
 
@@ -71,6 +72,56 @@ export const sanitizeHtml = (html: string): string => {
   return clean.replace(/<img /g, '<img referrerpolicy="no-referrer" style="max-width:600px" ')
 }
 
+/**
+ * Build a whole-word, case-insensitive pattern for the viewer's masked words,
+ * or null if there are none.
+ */
+export const maskPattern = (words: string[]): RegExp | null => {
+  const escaped = words
+    .filter((word) => word.trim())
+    .map((word) => word.trim().replace(/[.*+?^${}()|[\]\\]/g, '\\$&'))
+  if (escaped.length === 0) return null
+  return new RegExp(`(?<![\\p{L}\\p{N}])(${escaped.join('|')})(?![\\p{L}\\p{N}])`, 'giu')
+}
+
+/**
+ * Wrap masked words in sanitized HTML in <span class="masked">. Only text
+ * between tags is touched, so attributes and URLs are left alone.
+ */
+export const maskHtml = (html: string, words: string[]): string => {
+  const pattern = maskPattern(words)
+  if (!pattern) return html
+  return html
+    .split(/(<[^>]*>)/)
+    .map((part) => part.startsWith('<') ? part : part.replace(pattern, '<span class="masked">$1</span>'))
+    .join('')
+}
+
+/**
+ * Split plain text into runs of unmasked and masked text.
+ */
+export const maskText = (text: string, words: string[]): { text: string; masked: boolean }[] => {
+  const pattern = maskPattern(words)
+  if (!pattern) return [{ text, masked: false }]
+  return text
+    .split(pattern)
+    .map((part, i) => ({ text: part, masked: i % 2 === 1 }))
+    .filter((part) => part.text)
+}
+
+/**
+ * Click handler revealing the masked word under the pointer. The first click
+ * only reveals, so a masked word inside a link or post card does not also
+ * follow it.
+ */
+export const revealMasked = (event: MouseEvent<HTMLElement>) => {
+  const masked = (event.target as HTMLElement).closest('.masked')
+  if (!masked || masked.classList.contains('revealed')) return
+  masked.classList.add('revealed')
+  event.preventDefault()
+  event.stopPropagation()
+}
+
 /**
  * Convert standalone YouTube/Vimeo links in HTML to responsive iframe embeds.
  * Only replaces <a> tags that are the sole content of their <p> tag.
@@ -8,7 +8,7 @@ import { useMemo } from 'react'
 
 import { mapPosts } from '@/api/adapters'
 import { feedsApi } from '@/api/feeds'
-import type { FeedPermissions, FeedPost, Post } from '@/types'
+import type { FeedNotice, FeedPermissions, FeedPost, Post } from '@/types'
 
 const DEFAULT_LIMIT = 20
 
@@ -29,6 +29,7 @@ interface UseInfinitePostsOptions {
 interface UseInfinitePostsResult {
   posts: FeedPost[]
   permissions: FeedPermissions | undefined
+  notices: FeedNotice[]
 
   hasAi: boolean
 
@@ -46,6 +47,7 @@ type InfinitePostsPage = {
   hasMore: boolean
   nextCursor: number | undefined
   permissions: FeedPermissions | undefined
+  notices: FeedNotice[]
   hasAi: boolean
 }
 
@@ -84,12 +86,13 @@ export function useInfinitePosts({
     queryFn: async ({ pageParam }) => {
       if (!aggregate && !feedId) throw new Error("Feed ID required")
 
-      const isRelevanceSort = sort === 'interests' || sort === 'ai' || sort === 'relevant'
+      // Relevance sorts and the combined timeline's fair sort page by offset
+      const isOffsetSort = sort === 'interests' || sort === 'ai' || sort === 'relevant' || (aggregate && sort === 'fair')
 
       const cursor = {
         limit,
-        before: isRelevanceSort ? undefined : (pageParam as number | undefined),
-        offset: isRelevanceSort ? (pageParam as number | undefined) : undefined,
+        before: isOffsetSort ? undefined : (pageParam as number | undefined),
+        offset: isOffsetSort ? (pageParam as number | undefined) : undefined,
         sort,
         unread: unread ? '1' : undefined,
       }
@@ -102,6 +105,7 @@ export function useInfinitePosts({
         hasMore?: boolean
         nextCursor?: number
         permissions?: FeedPermissions
+        notices?: FeedNotice[]
 
         hasAi?: boolean
 
@@ -114,6 +118,7 @@ export function useInfinitePosts({
         hasMore: data.hasMore ?? false,
         nextCursor: data.nextCursor,
         permissions: data.permissions,
+        notices: data.notices ?? [],
 
         hasAi: data.hasAi ?? false,
       } satisfies InfinitePostsPage
@@ -134,11 +139,14 @@ export function useInfinitePosts({
 
   const permissions = query.data?.pages?.[0]?.permissions
 
+  const notices = useMemo(() => query.data?.pages?.[0]?.notices ?? [], [query.data?.pages])
+
   const hasAi = query.data?.pages?.[0]?.hasAi ?? false
 
   return {
     posts,
     permissions,
+    notices,
     hasAi,
     isLoading: query.isLoading,
     isError: query.isError,
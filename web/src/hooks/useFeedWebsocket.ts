@@ -20,6 +20,7 @@ interface FeedWebsocketEvent {
     | 'post/create'
     | 'post/edit'
     | 'post/delete'
+    | 'post/sensitive'
     | 'comment/create'
     | 'comment/add'
     | 'comment/edit'
@@ -227,9 +228,10 @@ export function useFeedWebsocket(
 
       // A feed/update after subscribe means the owner finished pushing the
       // initial posts (server flipped `populated`); re-run the route loader so
-      // the feed leaves its loading state. It also falls through to the query
+      // the feed leaves its loading state. sync/progress reloads it too, to
+      // update the syncing percentage. Both fall through to the query
       // invalidation below.
-      if (eventType === 'feed/update') {
+      if (eventType === 'feed/update' || eventType === 'sync/progress') {
         onSyncRef.current?.()
       }
 
@@ -252,6 +254,7 @@ export function useFeedWebsocket(
         case 'post/create':
         case 'post/edit':
         case 'post/delete':
+        case 'post/sensitive':
         case 'comment/create':
         case 'comment/add':
         case 'comment/edit':
@@ -29,6 +29,8 @@ import {
 import { mapPosts } from '@/api/adapters'
 import type { FeedPermissions, FeedPost, ReactionId } from '@/types'
 import { FeedPosts } from '@/features/feeds/components/feed-posts'
+import { PostHighlights } from '@/features/feeds/components/post-highlights'
+import { RelatedPosts } from '@/features/feeds/components/related-posts'
 import { patchPostReaction } from '@/features/feeds/utils'
 import { FileQuestion, ArrowLeft } from 'lucide-react'
 import { useSidebarContext } from '@/context/sidebar-context'
@@ -397,6 +399,8 @@ function SinglePostPage() {
           isLoggedIn={isLoggedIn}
           singlePost
         />
+        <PostHighlights feedId={feedId} postId={post.id} />
+        <RelatedPosts feedId={feedId} postId={post.id} />
       </Main>
     </>
   )
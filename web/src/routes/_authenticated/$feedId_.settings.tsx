@@ -49,9 +49,9 @@ import {
 } from '@mochi/web'
 import { useQuery } from '@tanstack/react-query'
 import { useFeeds, useSubscription } from '@/hooks'
-import { feedsApi, type AccessRule } from '@/api/feeds'
+import { feedsApi, type AccessRule, type Transfer } from '@/api/feeds'
 import { mapFeedsToSummaries } from '@/api/adapters'
-import type { Feed, FeedSummary } from '@/types'
+import type { Feed, FeedLayout, FeedRating, FeedSummary } from '@/types'
 import { useFeedsStore } from '@/stores/feeds-store'
 import { useSidebarContext } from '@/context/sidebar-context'
 import {
@@ -368,7 +368,10 @@ function FeedSettingsPage() {
             />
           )}
           {activeTab === 'access' && selectedFeed.isOwner && (
-            <AccessTab feedId={selectedFeed.id} />
+            <>
+              <AccessTab feedId={selectedFeed.id} />
+              <TransfersSection feedId={selectedFeed.id} />
+            </>
           )}
         </div>
       </Main>
@@ -449,6 +452,14 @@ function GeneralTab({
         <BannerSection feedId={feed.id} />
       )}
 
+      {feed.isOwner && (
+        <AppearanceSection feedId={feed.id} />
+      )}
+
+      {feed.isOwner && (
+        <RatingSection feedId={feed.id} />
+      )}
+
       {feed.isOwner ? (
         <AiSettingsSection feedId={feed.id} aiMode={feed.ai_mode ?? ''} aiAccount={feed.ai_account ?? ''} onSave={(mode, account) => {
           setFeeds(prev => prev.map(f => f.id === feed.id ? { ...f, ai_mode: mode, ai_account: account } : f))
@@ -605,6 +616,116 @@ function BannerSection({ feedId }: { feedId: string }) {
   )
 }
 
+// Custom CSS and page width for the feed. The server keeps only the style
+// rules it accepts, so the stored CSS is shown back after saving.
+function AppearanceSection({ feedId }: { feedId: string }) {
+  const { t } = useLingui()
+  const [css, setCssText] = useState('')
+  const [layout, setLayout] = useState<FeedLayout>('')
+  const [loaded, setLoaded] = useState(false)
+  const [saving, setSaving] = useState(false)
+  const [dirty, setDirty] = useState(false)
+
+  useEffect(() => {
+    feedsApi.getInfo(feedId).then((res) => {
+      setCssText(res.data.feed?.css ?? '')
+      setLayout(res.data.feed?.layout ?? '')
+      setLoaded(true)
+    }).catch(() => setLoaded(true))
+  }, [feedId])
+
+  const handleSave = async () => {
+    setSaving(true)
+    try {
+      const saved = await feedsApi.setCss(feedId, css, layout)
+      setCssText(saved.css)
+      setDirty(false)
+      toast.success(t`Appearance updated`)
+    } catch (error) {
+      toast.error(getErrorMessage(error, t`Failed to update appearance`))
+    } finally {
+      setSaving(false)
+    }
+  }
+
+  if (!loaded) return null
+
+  return (
+    <Section title={t`Appearance`} description={t`Style rules applied to your feed's page. Colours, fonts, borders and spacing are allowed; images, imports and at-rules are not.`}>
+      <div className="space-y-3 max-w-lg">
+        <Select value={layout || 'default'} onValueChange={(value) => { setLayout(value === 'default' ? '' : value as FeedLayout); setDirty(true) }}>
+          <SelectTrigger className="w-48">
+            <SelectValue />
+          </SelectTrigger>
+          <SelectContent>
+            <SelectItem value="default"><Trans>Full width</Trans></SelectItem>
+            <SelectItem value="narrow"><Trans>Narrow column</Trans></SelectItem>
+            <SelectItem value="wide"><Trans>Wide column</Trans></SelectItem>
+          </SelectContent>
+        </Select>
+        <Textarea
+          value={css}
+          onChange={(e) => { setCssText(e.target.value); setDirty(true) }}
+          placeholder={'h2 { color: #336699; }'}
+          rows={6}
+          className="font-mono text-sm"
+        />
+        <Button
+          size="sm"
+          onClick={() => void handleSave()}
+          disabled={saving || !dirty}
+        >
+          {saving && <Loader2 className="me-2 size-4 animate-spin" />}
+          <Trans>Save</Trans>
+        </Button>
+      </div>
+    </Section>
+  )
+}
+
+function RatingSection({ feedId }: { feedId: string }) {
+  const { t } = useLingui()
+  const [rating, setRatingValue] = useState<FeedRating>('')
+  const [loaded, setLoaded] = useState(false)
+
+  useEffect(() => {
+    feedsApi.getInfo(feedId).then((res) => {
+      setRatingValue(res.data.feed?.rating ?? '')
+      setLoaded(true)
+    }).catch(() => setLoaded(true))
+  }, [feedId])
+
+  const handleChange = async (value: string) => {
+    const previous = rating
+    const next = (value === 'unrated' ? '' : value) as FeedRating
+    setRatingValue(next)
+    try {
+      setRatingValue(await feedsApi.setRating(feedId, next))
+      toast.success(t`Rating updated`)
+    } catch (error) {
+      setRatingValue(previous)
+      toast.error(getErrorMessage(error, t`Failed to update rating`))
+    }
+  }
+
+  if (!loaded) return null
+
+  return (
+    <Section title={t`Rating`} description={t`Mature feeds are listed in search only for people who choose to see them.`}>
+      <Select value={rating || 'unrated'} onValueChange={(value) => void handleChange(value)}>
+        <SelectTrigger className="w-48">
+          <SelectValue />
+        </SelectTrigger>
+        <SelectContent>
+          <SelectItem value="unrated"><Trans>Unrated</Trans></SelectItem>
+          <SelectItem value="general"><Trans>General</Trans></SelectItem>
+          <SelectItem value="mature"><Trans>Mature</Trans></SelectItem>
+        </SelectContent>
+      </Select>
+    </Section>
+  )
+}
+
 // Account id "0" (and absence) is the "use default account" sentinel. Radix
 // Select items can't carry an empty-string value, so the Default item uses "0"
 // and an empty stored id is displayed as "0".
@@ -1041,3 +1162,51 @@ function AccessTab({ feedId }: AccessTabProps) {
     </Section>
   )
 }
+
+// Subscriptions handed to another identity, whose access waits for the owner
+function TransfersSection({ feedId }: { feedId: string }) {
+  const { t } = useLingui()
+  const { data, refetch } = useQuery({
+    queryKey: ['feeds', 'transfers', feedId],
+    queryFn: () => feedsApi.getTransfers(feedId),
+    retry: false,
+    refetchOnWindowFocus: false,
+  })
+  const transfers = coerceObjectArray<Transfer>(data)
+
+  const handleAnswer = async (transfer: Transfer, approve: boolean) => {
+    try {
+      await feedsApi.answerTransfer(feedId, transfer.previous, approve)
+      toast.success(approve ? t`Access transferred` : t`Transfer declined`)
+      await refetch()
+    } catch (err) {
+      toast.error(getErrorMessage(err, t`Failed to answer transfer`))
+    }
+  }
+
+  if (transfers.length === 0) return null
+
+  return (
+    <Section
+      title={t`Subscription transfers`}
+      description={t`Subscribers who moved to another identity and asked for their access to move with them.`}
+    >
+      <div className="space-y-2">
+        {transfers.map((transfer) => (
+          <div key={transfer.previous} className="flex flex-wrap items-center gap-2">
+            <DataChip value={transfer.previous} truncate='middle' />
+            <span className="text-sm text-muted-foreground">&rarr;</span>
+            <DataChip value={transfer.successor} truncate='middle' />
+            <span className="text-sm text-muted-foreground">{transfer.operations.join(', ')}</span>
+            <Button size="sm" onClick={() => void handleAnswer(transfer, true)}>
+              <Trans>Approve</Trans>
+            </Button>
+            <Button size="sm" variant="outline" onClick={() => void handleAnswer(transfer, false)}>
+              <Trans>Decline</Trans>
+            </Button>
+          </div>
+        ))}
+      </div>
+    </Section>
+  )
+}
@@ -44,9 +44,9 @@ function FindFeedsPage() {
     [feeds]
   )
 
-  const handleSubscribe = useCallback(async (feedId: string, entity: { id: string; name: string; location?: string; peer?: string }) => {
+  const handleSubscribe = useCallback(async (feedId: string, entity: { id: string; name: string; location?: string; peer?: string; invite?: string }) => {
     try {
-      await toastAction(feedsApi.subscribe(feedId, entity.location, entity.peer), {
+      await toastAction(feedsApi.subscribe(feedId, entity.location, entity.peer, entity.invite), {
         loading: t`Subscribing...`,
         success: t`Subscribed`,
         error: (e) => getErrorMessage(e, t`Failed to subscribe`),
@@ -62,7 +62,7 @@ function FindFeedsPage() {
   const resolveUri = useCallback(async (url: string) => {
     const { data } = await feedsApi.probe({ url })
     if (!data?.id) return null
-    return { ...data, location: data.server ?? '', peer: data.peer }
+    return { ...data, location: data.server ?? '', peer: data.peer, invite: data.invite }
   }, [])
 
   return (
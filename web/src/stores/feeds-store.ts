@@ -16,10 +16,16 @@ type FeedsState = {
   isLoading: boolean
   error: string | null
   defaultSort: string
+  maskedWords: string[]
+  showSensitive: boolean
+  showMature: boolean
   refresh: () => Promise<void>
   adjustUnread: (feedId: string, delta: number) => void
   setUnread: (feedId: string, count: number) => void
   setDefaultSort: (sort: string) => Promise<void>
+  setMaskedWords: (words: string[]) => Promise<void>
+  setShowSensitive: (show: boolean) => Promise<void>
+  setShowMature: (show: boolean) => Promise<void>
   setFeedSort: (feedId: string, sort: string) => Promise<void>
   // Cache for remote feeds (from search results)
   remoteFeedsCache: Record<string, FeedSummary>
@@ -40,6 +46,9 @@ export const useFeedsStore = create<FeedsState>()((set, get, api) => ({
   isLoading: false,
   error: null,
   defaultSort: '',
+  maskedWords: [],
+  showSensitive: false,
+  showMature: false,
   remoteFeedsCache: {},
 
   adjustUnread: (feedId: string, delta: number) => {
@@ -117,12 +126,16 @@ export const useFeedsStore = create<FeedsState>()((set, get, api) => ({
       const mappedPosts = mapPosts(data.posts)
       const postsByFeed = groupPostsByFeed(mappedPosts)
 
-      const defaultSort =
+      const settings =
         data && typeof data === 'object' && 'settings' in data
-          ? ((data as { settings?: { sort?: string } }).settings?.sort ?? '')
-          : ''
+          ? (data as { settings?: { sort?: string; masked?: string[]; sensitive?: number; mature?: number } }).settings
+          : undefined
+      const defaultSort = settings?.sort ?? ''
+      const maskedWords = settings?.masked ?? []
+      const showSensitive = settings?.sensitive === 1
+      const showMature = settings?.mature === 1
 
-      set({ feeds: dedupedFeeds, postsByFeed, defaultSort, isLoading: false })
+      set({ feeds: dedupedFeeds, postsByFeed, defaultSort, maskedWords, showSensitive, showMature, isLoading: false })
     } catch {
       set({ error: i18n._(msg`Failed to load feeds`), isLoading: false })
     }
@@ -138,6 +151,38 @@ export const useFeedsStore = create<FeedsState>()((set, get, api) => ({
     }
   },
 
+  setMaskedWords: async (words: string[]) => {
+    const previous = get().maskedWords
+    set({ maskedWords: words })
+    try {
+      set({ maskedWords: await feedsApi.setMaskedWords(words) })
+    } catch (error) {
+      // Unlike sort, a failed write reverts and is reported: masking is a
+      // safety setting and the user should not believe words are hidden when
+      // they are not.
+      set({ maskedWords: previous })
+      throw error
+    }
+  },
+
+  setShowSensitive: async (show: boolean) => {
+    set({ showSensitive: show })
+    try {
+      await feedsApi.setShowSensitive(show)
+    } catch {
+      // See note in setDefaultSort.
+    }
+  },
+
+  setShowMature: async (show: boolean) => {
+    set({ showMature: show })
+    try {
+      await feedsApi.setShowMature(show)
+    } catch {
+      // See note in setDefaultSort.
+    }
+  },
+
   setFeedSort: async (feedId: string, sort: string) => {
     set((state) => ({
       feeds: state.feeds.map((f) =>
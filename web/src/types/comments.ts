@@ -24,6 +24,8 @@ export interface Comment {
   reactions: Reaction[]
   attachments?: Attachment[]
   children: Comment[]
+  warning?: string
+  highlighted?: number
 }
 
 // Client-side comment for display
@@ -38,6 +40,8 @@ export interface FeedComment {
   userReaction?: ReactionId | null
   attachments?: Attachment[]
   replies?: FeedComment[]
+  warning?: string
+  highlighted?: boolean
 }
 
 // New comment form
@@ -63,6 +67,7 @@ export interface CreateCommentRequest {
   parent?: string
   id?: string
   files?: File[]
+  warning?: string
 }
 
 export interface CreateCommentResponse {
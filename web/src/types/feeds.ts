@@ -6,6 +6,20 @@
 // Feed privacy options
 export type FeedPrivacy = 'public' | 'private'
 
+// Owner's maturity rating; empty means unrated, treated as general
+export type FeedRating = '' | 'general' | 'mature'
+
+// Width of the feed page; empty means the app's default
+export type FeedLayout = '' | 'narrow' | 'wide'
+
+// A one-off notice from the feed's owner about the feed itself
+export interface FeedNotice {
+  id: string
+  body: string
+  created: number
+  expires: number
+}
+
 // Permissions
 export interface FeedPermissions {
   view: boolean
@@ -37,6 +51,23 @@ export interface Feed {
   // 1 (or absent, for owned) once present. The feed shows a loading state while
   // this is 0.
   populated?: number
+  // Items (posts and comments) the owner is sending while populated is 0, and
+  // how many it has sent so far
+  backfill_total?: number
+  backfill_sent?: number
+  // Owner's formatting limits, on a single feed's info
+  formatting?: FeedFormatting
+  rating?: FeedRating
+  // Owner's custom stylesheet, served scoped from the css endpoint, and layout
+  css?: string
+  layout?: FeedLayout
+}
+
+// Plain shows posts and comments without Markdown; comment_images allows
+// images attached to comments
+export interface FeedFormatting {
+  plain: boolean
+  comment_images: boolean
 }
 
 // Directory entry for search results
@@ -50,6 +81,10 @@ export interface DirectoryEntry {
   location?: string
   /** owner's peer from a mochi:// share-link probe; subscribe pins the same peer. */
   peer?: string
+  /** token from a mochi:// invite link, admitting the subscriber to a private feed. */
+  invite?: string
+  /** owner's rating published to the directory; mature feeds only appear if the user shows them. */
+  rating?: FeedRating
 }
 
 // Probe entry for URL-based remote feed lookup
@@ -61,6 +96,8 @@ export interface ProbeEntry {
   server?: string
   /** owner's peer from a mochi:// share-link probe; subscribe pins the same peer. */
   peer?: string
+  /** token from a mochi:// invite link, admitting the subscriber to a private feed. */
+  invite?: string
   remote: boolean
 }
 
@@ -102,6 +139,7 @@ export interface ViewFeedResponse {
     hasMore?: boolean
     nextCursor?: number  // Timestamp to use as 'before' for next page
     permissions?: FeedPermissions
+    notices?: FeedNotice[]
   }
 }
 
@@ -110,6 +148,7 @@ export interface CreateFeedRequest {
   name: string
   privacy: FeedPrivacy
   memories?: boolean
+  rating?: FeedRating
 }
 
 export interface CreateFeedResponse {
@@ -9,6 +9,10 @@ export type {
   DeleteFeedResponse,
   DirectoryEntry,
   Feed,
+  FeedFormatting,
+  FeedLayout,
+  FeedNotice,
+  FeedRating,
   FeedInfoClassResponse,
   FeedInfoEntityResponse,
   FeedInfoResponse,
@@ -48,6 +52,7 @@ export type {
   ReactToPostResponse,
   Post,
   PostSource,
+  PostVisibility,
   SavedItem,
   SavedPostSnapshot,
   Tag,
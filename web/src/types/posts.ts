@@ -78,7 +78,8 @@ export interface Post {
   updated: number
   attachments: Attachment[]
   my_reaction: string
-  reactions: Reaction[]
+  reactions?: Reaction[]
+  reaction_counts?: Record<string, number>
   comments: Comment[]
   tags?: Tag[]
   up: number
@@ -86,6 +87,12 @@ export interface Post {
   read: number
   source?: PostSource
   score?: number
+  language?: string
+  visibility?: PostVisibility
+  warning?: string
+  sensitive?: number
+  title?: string
+  summary?: string
 }
 
 // Client-side post for display
@@ -113,6 +120,10 @@ export interface FeedPost {
   read?: number
   source?: PostSource
   score?: number
+  warning?: string
+  sensitive?: boolean
+  title?: string
+  summary?: string
 }
 
 // Slim point-in-time snapshot stored for the "Saved" (read-later) feature.
@@ -153,11 +164,21 @@ export interface GetNewPostResponse {
 }
 
 // Create post
+// Who a post is for: anyone who can see the feed, subscribers only, or the
+// subscribers listed in audience
+export type PostVisibility = '' | 'public' | 'subscribers' | 'selected'
+
 export interface CreatePostRequest {
   feed: string
   body: string
   data?: PostData
   files?: File[]
+  visibility?: PostVisibility
+  audience?: string[]
+  warning?: string
+  sensitive?: boolean
+  title?: string
+  summary?: string
 }
 
 export interface CreatePostResponse {
@@ -189,6 +210,9 @@ export interface EditPostRequest {
   post: string
   body: string
   data?: PostData // location data (checkin, travelling)
+  warning?: string // content warning; '-' removes it
+  title?: string // '-' removes it
+  summary?: string // '-' removes it
   order?: string[] // order list with existing IDs and "new:N" placeholders for new files
   files?: File[] // new files to add
 }